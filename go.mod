@@ -1,14 +1,20 @@
 module github.com/undernet00/MarsRoverGo
 
-go 1.19
+go 1.20
 
 require (
+	github.com/gorilla/websocket v1.5.1
 	github.com/stretchr/testify v1.8.2
-	golang.org/x/text v0.9.0
+	golang.org/x/text v0.14.0
+	google.golang.org/grpc v1.63.2
+	google.golang.org/protobuf v1.34.2
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
+	golang.org/x/net v0.21.0 // indirect
+	golang.org/x/sys v0.17.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240227224415-6ceb2ff114de // indirect
 )
@@ -1,11 +1,48 @@
 package main
 
 import (
+	"encoding/json"
+	"flag"
 	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+
 	planetarymap "github.com/undernet00/MarsRoverGo/pkg/domain"
+	"github.com/undernet00/MarsRoverGo/pkg/feed"
+	"github.com/undernet00/MarsRoverGo/pkg/repl"
+	"github.com/undernet00/MarsRoverGo/pkg/rpc"
 )
 
 func main() {
+
+	if len(os.Args) > 1 {
+		var err error
+		switch os.Args[1] {
+		case "repl":
+			err = runRepl(os.Args[2:])
+		case "travel":
+			err = runTravel(os.Args[2:])
+		case "serve":
+			err = runServe(os.Args[2:])
+		case "feed":
+			err = runFeed(os.Args[2:])
+		case "analyze":
+			err = runAnalyze(os.Args[2:])
+		default:
+			err = fmt.Errorf("unknown command %v, use repl, travel, serve, feed or analyze", os.Args[1])
+		}
+
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	p := planetarymap.NewPlanetaryMap(4, 4)
 	r := planetarymap.NewRover(*p)
 
@@ -15,3 +52,275 @@ func main() {
 	fmt.Println(err)
 
 }
+
+// options are the flags shared by the subcommands. The ones a subcommand does not register are nil.
+type options struct {
+	mapFile      *string
+	width        *int
+	height       *int
+	x            *int
+	y            *int
+	orientation  *string
+	languageCode *string
+	lowercase    *bool
+	format       *string
+}
+
+func newOptions(flags *flag.FlagSet) options {
+
+	o := newAlphabetOptions(flags)
+	o.mapFile = flags.String("map", "", "JSON file with the map spec, overrides width and height")
+	o.width = flags.Int("width", 5, "width of the map")
+	o.height = flags.Int("height", 5, "height of the map")
+	o.x = flags.Int("x", 0, "initial x coordinate of the rover")
+	o.y = flags.Int("y", 0, "initial y coordinate of the rover")
+	o.orientation = flags.String("orientation", "N", "initial orientation of the rover")
+	o.format = flags.String("format", planetarymap.LegacyFormat, "output format, one of "+strings.Join(planetarymap.FormatterNames(), ", "))
+
+	return o
+}
+
+// newAlphabetOptions only registers the flags choosing the alphabet, for subcommands that do not place a rover.
+func newAlphabetOptions(flags *flag.FlagSet) options {
+	return options{
+		languageCode: flags.String("language", "en", "alphabet for commands and orientations, en or es"),
+		lowercase:    flags.Bool("lowercase", false, "accept lowercase commands and orientations"),
+	}
+}
+
+// mapSpec returns the spec read from the map file, or a rectangle of the given width and height.
+func (o options) mapSpec() (planetarymap.MapSpec, error) {
+
+	spec := planetarymap.MapSpec{Kind: planetarymap.RectangleMapKind, Width: *o.width, Height: *o.height}
+	if *o.mapFile == "" {
+		return spec, nil
+	}
+
+	content, err := os.ReadFile(*o.mapFile)
+	if err != nil {
+		return spec, err
+	}
+
+	err = json.Unmarshal(content, &spec)
+	return spec, err
+}
+
+func (o options) alphabet() (planetarymap.Alphabet, error) {
+
+	alphabet, ok := planetarymap.LookupAlphabet(*o.languageCode)
+	if !ok {
+		return alphabet, fmt.Errorf("%v is not a supported language", *o.languageCode)
+	}
+
+	if *o.lowercase {
+		alphabet = alphabet.WithLowercase()
+	}
+
+	return alphabet, nil
+}
+
+func (o options) formatter() (planetarymap.Formatter, error) {
+
+	formatter, ok := planetarymap.LookupFormatter(*o.format)
+	if !ok {
+		return nil, fmt.Errorf("%v is not a valid format, use one of %v", *o.format, strings.Join(planetarymap.FormatterNames(), ", "))
+	}
+
+	return formatter, nil
+}
+
+func (o options) start(alphabet planetarymap.Alphabet) (planetarymap.Pose, error) {
+
+	orientation, err := alphabet.ParseOrientation(*o.orientation)
+	if err != nil {
+		return planetarymap.Pose{}, err
+	}
+
+	return planetarymap.Pose{X: *o.x, Y: *o.y, Orientation: orientation}, nil
+}
+
+// runRepl starts an interactive session reading commands from the standard input.
+func runRepl(arguments []string) error {
+
+	flags := flag.NewFlagSet("repl", flag.ContinueOnError)
+	o := newOptions(flags)
+	if err := flags.Parse(arguments); err != nil {
+		return err
+	}
+
+	spec, err := o.mapSpec()
+	if err != nil {
+		return err
+	}
+
+	alphabet, err := o.alphabet()
+	if err != nil {
+		return err
+	}
+
+	formatter, err := o.formatter()
+	if err != nil {
+		return err
+	}
+
+	start, err := o.start(alphabet)
+	if err != nil {
+		return err
+	}
+
+	session, err := repl.NewSession(spec, start, os.Stdout)
+	if err != nil {
+		return err
+	}
+	session.UseAlphabet(alphabet)
+	session.UseFormatter(formatter)
+
+	return session.Run(os.Stdin)
+}
+
+// runTravel executes a single list of commands and prints the result.
+func runTravel(arguments []string) error {
+
+	flags := flag.NewFlagSet("travel", flag.ContinueOnError)
+	o := newOptions(flags)
+	commands := flags.String("commands", "", "list of commands to execute")
+	if err := flags.Parse(arguments); err != nil {
+		return err
+	}
+
+	spec, err := o.mapSpec()
+	if err != nil {
+		return err
+	}
+
+	navigationMap, err := spec.Build()
+	if err != nil {
+		return err
+	}
+
+	alphabet, err := o.alphabet()
+	if err != nil {
+		return err
+	}
+
+	formatter, err := o.formatter()
+	if err != nil {
+		return err
+	}
+
+	start, err := o.start(alphabet)
+	if err != nil {
+		return err
+	}
+
+	rover := planetarymap.NewRover(navigationMap)
+	rover.UseAlphabet(alphabet)
+	rover.UseFormatter(formatter)
+
+	output, err := rover.Travel(start.X, start.Y, start.Orientation, *commands)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(output)
+	return nil
+}
+
+// runServe serves the gRPC rover service until the process is stopped.
+func runServe(arguments []string) error {
+
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	address := flags.String("address", ":50051", "address the gRPC server listens on")
+	if err := flags.Parse(arguments); err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", *address)
+	if err != nil {
+		return err
+	}
+
+	server := grpc.NewServer()
+	rpc.NewServer().Register(server)
+
+	fmt.Printf("serving gRPC on %v\n", listener.Addr())
+	return server.Serve(listener)
+}
+
+// runFeed serves a WebSocket telemetry feed of a rover named rover on /telemetry.
+func runFeed(arguments []string) error {
+
+	flags := flag.NewFlagSet("feed", flag.ContinueOnError)
+	o := newOptions(flags)
+	address := flags.String("address", ":8080", "address the HTTP server listens on")
+	queueSize := flags.Int("queue", 256, "updates queued for each client before the oldest ones are dropped")
+	if err := flags.Parse(arguments); err != nil {
+		return err
+	}
+
+	spec, err := o.mapSpec()
+	if err != nil {
+		return err
+	}
+
+	navigationMap, err := spec.Build()
+	if err != nil {
+		return err
+	}
+
+	alphabet, err := o.alphabet()
+	if err != nil {
+		return err
+	}
+
+	start, err := o.start(alphabet)
+	if err != nil {
+		return err
+	}
+
+	rover := planetarymap.NewRover(navigationMap)
+	rover.UseAlphabet(alphabet)
+
+	hub := feed.NewHub(*queueSize)
+	if err := hub.AddRover("rover", rover, start); err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/telemetry", hub)
+
+	fmt.Printf("serving telemetry on ws://%v/telemetry\n", *address)
+	return http.ListenAndServe(*address, mux)
+}
+
+// runAnalyze prints the optimized version of a list of commands and its warnings.
+func runAnalyze(arguments []string) error {
+
+	flags := flag.NewFlagSet("analyze", flag.ContinueOnError)
+	o := newAlphabetOptions(flags)
+	commands := flags.String("commands", "", "list of commands to analyze")
+	if err := flags.Parse(arguments); err != nil {
+		return err
+	}
+
+	alphabet, err := o.alphabet()
+	if err != nil {
+		return err
+	}
+
+	analysis, err := alphabet.AnalyzeCommands(*commands)
+	if err != nil {
+		return err
+	}
+
+	if analysis.NoOp {
+		fmt.Println("the plan has no effect, there are no commands to send")
+	} else {
+		fmt.Println(analysis.Optimized)
+	}
+	for _, warning := range analysis.Warnings {
+		fmt.Printf("warning: %v\n", warning.Message)
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package rover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrActorStopped is the error of the batches still in the mailbox when an Actor stops.
+var ErrActorStopped = errors.New("rover actor stopped")
+
+// PanicError is the error of a batch whose handling panicked. The Actor was restarted from its last snapshot.
+type PanicError struct {
+	Value any
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("rover panicked: %v", e.Value)
+}
+
+// Batch is a list of commands sent to an Actor. The ID is copied to its Reply.
+type Batch struct {
+	ID       int
+	Commands string
+}
+
+// Snapshot is the state an Actor is restarted from: the pose left by the last batch that completed, and how many
+// batches and restarts there have been.
+type Snapshot struct {
+	Pose     Pose
+	Batches  int
+	Restarts int
+}
+
+// Reply is the outcome of a Batch, with the Actor's snapshot after handling it.
+type Reply struct {
+	ID       int
+	Result   TravelResult
+	Err      error
+	Snapshot Snapshot
+}
+
+// Actor runs a Rover on its own goroutine. Batches sent to its mailbox are executed one after the other, each
+// one from the pose left by the previous one, and their replies sent to its reply channel.
+//
+// When handling a batch panics, for example in a step listener, the Actor replies with a PanicError and goes on
+// with a new Rover placed at the pose of its last snapshot. If no new Rover can be made, the old one is moved back
+// to that pose.
+type Actor struct {
+	name     string
+	newRover func() *Rover
+	rover    *Rover
+	snapshot Snapshot
+	mailbox  chan Batch
+	replies  chan Reply
+}
+
+// NewActor creates an Actor with a Rover made by newRover, placed at the start pose. newRover is called again
+// on every restart, so it must configure the Rover completely, including its listeners.
+func NewActor(name string, newRover func() *Rover, start Pose, mailboxSize int) (*Actor, error) {
+
+	newActor := Actor{
+		name:     name,
+		newRover: newRover,
+		snapshot: Snapshot{Pose: start},
+		mailbox:  make(chan Batch, mailboxSize),
+		replies:  make(chan Reply, mailboxSize),
+	}
+
+	if err := newActor.place(); err != nil {
+		return nil, err
+	}
+
+	return &newActor, nil
+}
+
+// Name returns the name the Actor was created with.
+func (a *Actor) Name() string {
+	return a.name
+}
+
+// Mailbox returns the channel batches are sent to.
+func (a *Actor) Mailbox() chan<- Batch {
+	return a.mailbox
+}
+
+// Replies returns the channel the replies are sent to. It is closed when Run returns.
+func (a *Actor) Replies() <-chan Reply {
+	return a.replies
+}
+
+// Run handles batches until the context is done. The batch being handled is completed, and the ones left in the
+// mailbox are replied with ErrActorStopped when there is room in the reply channel.
+func (a *Actor) Run(ctx context.Context) {
+
+	defer close(a.replies)
+
+	for {
+		select {
+		case <-ctx.Done():
+			a.drain()
+			return
+		case batch := <-a.mailbox:
+			if !a.reply(ctx, a.handle(batch)) {
+				a.drain()
+				return
+			}
+		}
+	}
+}
+
+// reply sends the reply of a handled batch, giving up only when the context is done and there is no room for it.
+func (a *Actor) reply(ctx context.Context, reply Reply) bool {
+
+	select {
+	case a.replies <- reply:
+		return true
+	default:
+	}
+
+	select {
+	case a.replies <- reply:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// handle executes a batch, restarting the Actor if it panics.
+func (a *Actor) handle(batch Batch) (reply Reply) {
+
+	reply.ID = batch.ID
+	defer func() {
+		if value := recover(); value != nil {
+			a.snapshot.Restarts++
+			reply = Reply{ID: batch.ID, Err: &PanicError{Value: value}, Snapshot: a.snapshot}
+			if err := a.place(); err != nil {
+				reply.Err = errors.Join(reply.Err, err)
+				a.rover.currentX, a.rover.currentY, a.rover.currentOrientation = a.snapshot.Pose.X, a.snapshot.Pose.Y, a.snapshot.Pose.Orientation
+			}
+		}
+	}()
+
+	commands, err := a.rover.alphabet.convertStringToCommands(batch.Commands)
+	if err != nil {
+		reply.Err = err
+		reply.Snapshot = a.snapshot
+		return reply
+	}
+
+	reply.Result = a.rover.run(a.rover.Pose(), commands)
+	a.snapshot.Pose = reply.Result.Pose
+	a.snapshot.Batches++
+	reply.Snapshot = a.snapshot
+
+	return reply
+}
+
+// place creates a new Rover at the pose of the snapshot.
+func (a *Actor) place() error {
+
+	rover := a.newRover()
+	start := a.snapshot.Pose
+	if err := rover.ValidatePose(start); err != nil {
+		return err
+	}
+
+	rover.currentX, rover.currentY, rover.currentOrientation = start.X, start.Y, start.Orientation
+	a.rover = rover
+
+	return nil
+}
+
+// drain replies to the batches left in the mailbox without waiting.
+func (a *Actor) drain() {
+
+	for {
+		select {
+		case batch := <-a.mailbox:
+			select {
+			case a.replies <- Reply{ID: batch.ID, Err: ErrActorStopped, Snapshot: a.snapshot}:
+			default:
+			}
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package rover
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// sendAndReceive runs the actor, sends it the batches and returns their replies.
+func sendAndReceive(actor *Actor, batches ...Batch) []Reply {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go actor.Run(ctx)
+
+	replies := make([]Reply, 0, len(batches))
+	for _, batch := range batches {
+		actor.Mailbox() <- batch
+		replies = append(replies, <-actor.Replies())
+	}
+
+	return replies
+}
+
+func TestActor(t *testing.T) {
+
+	newRover := func() *Rover { return NewRover(NewMap(4, 4)) }
+
+	testCases := []struct {
+		name    string
+		batches []Batch
+		asserts func(replies []Reply)
+	}{
+		{
+			name:    "Batches continue from the last pose",
+			batches: []Batch{{ID: 1, Commands: "AR"}, {ID: 2, Commands: "AA"}},
+			asserts: func(replies []Reply) {
+				assert.Equal(t, Reply{ID: 1, Result: TravelResult{Valid: true, Pose: Pose{0, 1, East}}, Snapshot: Snapshot{Pose: Pose{0, 1, East}, Batches: 1}}, replies[0])
+				assert.Equal(t, Reply{ID: 2, Result: TravelResult{Valid: true, Pose: Pose{2, 1, East}}, Snapshot: Snapshot{Pose: Pose{2, 1, East}, Batches: 2}}, replies[1])
+			},
+		},
+		{
+			name:    "Rejected advance",
+			batches: []Batch{{ID: 1, Commands: "LA"}},
+			asserts: func(replies []Reply) {
+				assert.Nil(t, replies[0].Err)
+				assert.Equal(t, TravelResult{Valid: false, Pose: Pose{0, 0, West}}, replies[0].Result)
+			},
+		},
+		{
+			name:    "Invalid commands leave the snapshot",
+			batches: []Batch{{ID: 1, Commands: "A"}, {ID: 2, Commands: "AX"}},
+			asserts: func(replies []Reply) {
+				assert.ErrorIs(t, replies[1].Err, ErrInvalidCommand)
+				assert.Equal(t, Snapshot{Pose: Pose{0, 1, North}, Batches: 1}, replies[1].Snapshot)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			// given
+			actor, err := NewActor("curiosity", newRover, Pose{0, 0, North}, 1)
+			assert.Nil(t, err)
+
+			// when
+			replies := sendAndReceive(actor, tc.batches...)
+
+			//then
+			tc.asserts(replies)
+		})
+	}
+}
+
+func TestActor_RestartsFromSnapshot(t *testing.T) {
+
+	// given
+	created := 0
+	newRover := func() *Rover {
+		created++
+		rover := NewRover(NewMap(4, 4))
+		rover.OnStep(func(event StepEvent) {
+			if event.Pose.X == 2 && event.Pose.Y == 2 {
+				panic("crater at (2,2)")
+			}
+		})
+		return rover
+	}
+
+	actor, err := NewActor("curiosity", newRover, Pose{2, 0, North}, 1)
+	assert.Nil(t, err)
+
+	// when
+	replies := sendAndReceive(actor, Batch{ID: 1, Commands: "A"}, Batch{ID: 2, Commands: "A"}, Batch{ID: 3, Commands: "RA"})
+
+	//then
+	assert.Nil(t, replies[0].Err)
+	assert.Equal(t, Reply{ID: 2, Err: &PanicError{Value: "crater at (2,2)"}, Snapshot: Snapshot{Pose: Pose{2, 1, North}, Batches: 1, Restarts: 1}}, replies[1])
+	assert.EqualError(t, replies[1].Err, "rover panicked: crater at (2,2)")
+	assert.Equal(t, Reply{ID: 3, Result: TravelResult{Valid: true, Pose: Pose{3, 1, East}}, Snapshot: Snapshot{Pose: Pose{3, 1, East}, Batches: 2, Restarts: 1}}, replies[2])
+	assert.Equal(t, 2, created)
+}
+
+func TestActor_RestartWithoutRover(t *testing.T) {
+
+	// given
+	rover := NewRover(NewMap(4, 4))
+	rover.OnStep(func(event StepEvent) {
+		if event.Pose.Y == 2 {
+			panic("crater")
+		}
+	})
+	calls := 0
+	newRover := func() *Rover {
+		calls++
+		if calls > 1 {
+			return nil
+		}
+		return rover
+	}
+
+	actor, err := NewActor("curiosity", newRover, Pose{0, 0, North}, 1)
+	assert.Nil(t, err)
+
+	// when
+	replies := sendAndReceive(actor, Batch{ID: 1, Commands: "AA"}, Batch{ID: 2, Commands: "RA"})
+
+	//then
+	assert.ErrorIs(t, replies[0].Err, ErrRoverNotInitialized)
+	var panicError *PanicError
+	assert.ErrorAs(t, replies[0].Err, &panicError)
+	assert.Equal(t, TravelResult{Valid: true, Pose: Pose{1, 0, East}}, replies[1].Result)
+}
+
+func TestActor_Shutdown(t *testing.T) {
+
+	// given
+	actor, err := NewActor("curiosity", func() *Rover { return NewRover(NewMap(4, 4)) }, Pose{0, 0, North}, 3)
+	assert.Nil(t, err)
+	for i := 1; i <= 3; i++ {
+		actor.Mailbox() <- Batch{ID: i, Commands: "A"}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// when
+	actor.Run(ctx)
+
+	//then
+	ids := []int{}
+	for reply := range actor.Replies() {
+		ids = append(ids, reply.ID)
+		if reply.Err != nil {
+			assert.ErrorIs(t, reply.Err, ErrActorStopped)
+		}
+	}
+	assert.Equal(t, []int{1, 2, 3}, ids)
+}
+
+func TestNewActor(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		newRover func() *Rover
+		start    Pose
+		expected error
+	}{
+		{
+			name:     "Nil rover",
+			newRover: func() *Rover { return nil },
+			start:    Pose{0, 0, North},
+			expected: ErrRoverNotInitialized,
+		},
+		{
+			name:     "Start outside the map",
+			newRover: func() *Rover { return NewRover(NewMap(2, 2)) },
+			start:    Pose{2, 0, North},
+			expected: ErrInvalidCoordinate,
+		},
+		{
+			name:     "Invalid orientation",
+			newRover: func() *Rover { return NewRover(NewMap(2, 2)) },
+			start:    Pose{0, 0, "X"},
+			expected: ErrInvalidOrientation,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			// when
+			actor, err := NewActor("curiosity", tc.newRover, tc.start, 1)
+
+			//then
+			assert.Nil(t, actor)
+			assert.ErrorIs(t, err, tc.expected)
+		})
+	}
+}
+
+func TestActor_Fleet(t *testing.T) {
+
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	m := NewMap(10, 10)
+
+	actors := make([]*Actor, 0, 8)
+	for i := 0; i < 8; i++ {
+		actor, err := NewActor(fmt.Sprint("rover-", i), func() *Rover { return NewRover(m) }, Pose{i, 0, North}, 4)
+		assert.Nil(t, err)
+		actors = append(actors, actor)
+		go actor.Run(ctx)
+	}
+
+	// when
+	wg := sync.WaitGroup{}
+	for _, actor := range actors {
+		wg.Add(1)
+		go func(actor *Actor) {
+			defer wg.Done()
+			for i := 0; i < 9; i++ {
+				actor.Mailbox() <- Batch{ID: i, Commands: "A"}
+			}
+		}(actor)
+	}
+
+	//then
+	for i, actor := range actors {
+		var last Reply
+		for j := 0; j < 9; j++ {
+			last = <-actor.Replies()
+		}
+		assert.Equal(t, Pose{i, 9, North}, last.Snapshot.Pose)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,235 @@
+package rover
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+// Alphabet maps the characters operators type to Commands and CardinalPoints, and sets the language used for
+// the Rover's output. When CaseInsensitive is set, lowercase characters are accepted too.
+type Alphabet struct {
+	Language        language.Tag
+	Commands        map[rune]Command
+	Orientations    map[rune]CardinalPoint
+	CaseInsensitive bool
+}
+
+// EnglishAlphabet is the default Alphabet: A/L/R for Advance, Left and Right and N/E/S/W for the CardinalPoints.
+var EnglishAlphabet = Alphabet{
+	Language:     language.English,
+	Commands:     map[rune]Command{'A': Advance, 'L': Left, 'R': Right},
+	Orientations: map[rune]CardinalPoint{'N': North, 'E': East, 'S': South, 'W': West},
+}
+
+// SpanishAlphabet uses A/I/D for Avanzar, Izquierda and Derecha and N/E/S/O for the CardinalPoints.
+var SpanishAlphabet = Alphabet{
+	Language:     language.Spanish,
+	Commands:     map[rune]Command{'A': Advance, 'I': Left, 'D': Right},
+	Orientations: map[rune]CardinalPoint{'N': North, 'E': East, 'S': South, 'O': West},
+}
+
+// LookupAlphabet returns the built-in Alphabet for a language code, either en or es.
+func LookupAlphabet(code string) (Alphabet, bool) {
+
+	switch code {
+	case "en":
+		return EnglishAlphabet, true
+	case "es":
+		return SpanishAlphabet, true
+	}
+
+	return Alphabet{}, false
+}
+
+// outcomeCatalog holds the translations of the words used to tell if a travel ended inside the map.
+var outcomeCatalog = func() catalog.Catalog {
+
+	builder := catalog.NewBuilder(catalog.Fallback(language.English))
+	_ = builder.SetString(language.English, "true", "true")
+	_ = builder.SetString(language.English, "false", "false")
+	_ = builder.SetString(language.Spanish, "true", "verdadero")
+	_ = builder.SetString(language.Spanish, "false", "falso")
+
+	return builder
+}()
+
+// WithLowercase returns a copy of the Alphabet that also accepts lowercase characters.
+func (a Alphabet) WithLowercase() Alphabet {
+	a.CaseInsensitive = true
+	return a
+}
+
+// Command returns the Command for a character.
+func (a Alphabet) Command(character rune) (Command, bool) {
+
+	command, ok := a.Commands[character]
+	if !ok && a.CaseInsensitive {
+		command, ok = a.Commands[unicode.ToUpper(character)]
+	}
+
+	return command, ok
+}
+
+// ParseOrientation returns the CardinalPoint for a single character string.
+func (a Alphabet) ParseOrientation(value string) (CardinalPoint, error) {
+
+	character, size := utf8.DecodeRuneInString(value)
+	if size == 0 || size != len(value) {
+		return "", &InvalidOrientationError{Orientation: CardinalPoint(value)}
+	}
+
+	orientation, ok := a.Orientations[character]
+	if !ok && a.CaseInsensitive {
+		orientation, ok = a.Orientations[unicode.ToUpper(character)]
+	}
+	if !ok {
+		return "", &InvalidOrientationError{Orientation: CardinalPoint(value)}
+	}
+
+	return orientation, nil
+}
+
+// Letter returns the character operators use for the CardinalPoint.
+func (a Alphabet) Letter(cp CardinalPoint) string {
+	return letterFor(a.Orientations, cp)
+}
+
+// CommandLetter returns the character operators use for the Command.
+func (a Alphabet) CommandLetter(c Command) string {
+	return letterFor(a.Commands, c)
+}
+
+// letterFor looks up the character of the Alphabet standing for the value, which is returned as it is when the
+// Alphabet has none.
+func letterFor[V ~string](characters map[rune]V, value V) string {
+
+	letter := rune(-1)
+	for character, v := range characters {
+		// The smallest character is chosen, so uppercase wins when both cases are in the Alphabet.
+		if v == value && (letter == -1 || character < letter) {
+			letter = character
+		}
+	}
+
+	if letter == -1 {
+		return string(value)
+	}
+
+	return string(letter)
+}
+
+// LocalizeCommands writes a string of Commands, like the commands of a Detour, with the Alphabet's letters.
+func (a Alphabet) LocalizeCommands(commands string) string {
+
+	var sb strings.Builder
+	sb.Grow(len(commands))
+	for _, c := range commands {
+		sb.WriteString(a.CommandLetter(Command(string(c))))
+	}
+
+	return sb.String()
+}
+
+// outcomeWords returns the localized and title cased words for a travel ending inside or outside the map.
+func (a Alphabet) outcomeWords() (string, string) {
+
+	printer := message.NewPrinter(a.Language, message.Catalog(outcomeCatalog))
+	caser := cases.Title(a.Language)
+
+	return caser.String(printer.Sprintf("true")), caser.String(printer.Sprintf("false"))
+}
+
+// convertStringToCommands will convert a string into a list of valid Rover commands, stopping at the first
+// invalid character.
+func (a Alphabet) convertStringToCommands(listOfCommands string) ([]Command, error) {
+	return a.appendCommands(make([]Command, 0, len(listOfCommands)), listOfCommands, nil)
+}
+
+// asciiCommands returns the Command of every ASCII character, empty for the characters that are not commands.
+func (a Alphabet) asciiCommands() *[utf8.RuneSelf]Command {
+
+	table := [utf8.RuneSelf]Command{}
+	for character := range table {
+		table[character], _ = a.Command(rune(character))
+	}
+
+	return &table
+}
+
+// appendCommands converts a list of commands and appends them to dst, stopping at the first invalid character.
+// It walks the bytes of the string, and looks ASCII characters up in the table made by asciiCommands when one
+// is given instead of in the Alphabet's maps, so nothing is allocated while dst has room for the commands.
+func (a Alphabet) appendCommands(dst []Command, listOfCommands string, ascii *[utf8.RuneSelf]Command) ([]Command, error) {
+
+	if listOfCommands == "" {
+		return nil, ErrEmptyCommands
+	}
+
+	position := 0
+	for i := 0; i < len(listOfCommands); {
+		position++
+
+		character, size := rune(listOfCommands[i]), 1
+		if character >= utf8.RuneSelf {
+			character, size = utf8.DecodeRuneInString(listOfCommands[i:])
+		}
+
+		command := Command("")
+		if ascii != nil && character < utf8.RuneSelf {
+			command = ascii[character]
+		} else {
+			command, _ = a.Command(character)
+		}
+
+		if command == "" {
+			return nil, a.invalidCommandError(position, character)
+		}
+
+		dst = append(dst, command)
+		i += size
+	}
+
+	return dst, nil
+}
+
+// ValidateCommands checks a whole list of commands and reports every invalid character at once, instead of
+// stopping at the first one like the execution path does. The returned error joins one InvalidCommandError per
+// invalid character, and it is nil when the list can be executed.
+func (a Alphabet) ValidateCommands(listOfCommands string) error {
+
+	if listOfCommands == "" {
+		return ErrEmptyCommands
+	}
+
+	var problems []error
+	position := 0
+	for _, character := range listOfCommands {
+		position++
+		if _, ok := a.Command(character); !ok {
+			problems = append(problems, a.invalidCommandError(position, character))
+		}
+	}
+
+	return errors.Join(problems...)
+}
+
+// invalidCommandError creates the error for the character, suggesting the uppercase character when it is a
+// lowercase command of the Alphabet.
+func (a Alphabet) invalidCommandError(position int, character rune) *InvalidCommandError {
+
+	err := InvalidCommandError{Position: position, Character: character}
+	if upper := unicode.ToUpper(character); upper != character {
+		if _, ok := a.Commands[upper]; ok {
+			err.Suggestion = upper
+		}
+	}
+
+	return &err
+}
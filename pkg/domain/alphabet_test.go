@@ -0,0 +1,206 @@
+package rover
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLookupAlphabet(t *testing.T) {
+
+	alphabet, ok := LookupAlphabet("en")
+	assert.True(t, ok)
+	assert.Equal(t, EnglishAlphabet, alphabet)
+
+	alphabet, ok = LookupAlphabet("es")
+	assert.True(t, ok)
+	assert.Equal(t, SpanishAlphabet, alphabet)
+
+	_, ok = LookupAlphabet("fr")
+	assert.False(t, ok)
+}
+
+func TestAlphabet_ConvertStringToCommands(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		alphabet           Alphabet
+		unverifiedCommands string
+		asserts            func(commands []Command, err error)
+	}{
+		{
+			name:               "Spanish commands",
+			alphabet:           SpanishAlphabet,
+			unverifiedCommands: "AIAD",
+			asserts: func(commands []Command, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, []Command{Advance, Left, Advance, Right}, commands)
+			},
+		},
+		{
+			name:               "English letters are not Spanish commands",
+			alphabet:           SpanishAlphabet,
+			unverifiedCommands: "AL",
+			asserts: func(commands []Command, err error) {
+				assert.Equal(t, &InvalidCommandError{Position: 2, Character: 'L'}, err)
+			},
+		},
+		{
+			name:               "Lowercase is rejected by default",
+			alphabet:           EnglishAlphabet,
+			unverifiedCommands: "alr",
+			asserts: func(commands []Command, err error) {
+				assert.Equal(t, &InvalidCommandError{Position: 1, Character: 'a', Suggestion: 'A'}, err)
+			},
+		},
+		{
+			name:               "Lowercase is accepted when enabled",
+			alphabet:           EnglishAlphabet.WithLowercase(),
+			unverifiedCommands: "alR",
+			asserts: func(commands []Command, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, []Command{Advance, Left, Right}, commands)
+			},
+		},
+		{
+			name:               "Spanish suggestion uses the Spanish letter",
+			alphabet:           SpanishAlphabet,
+			unverifiedCommands: "Ai",
+			asserts: func(commands []Command, err error) {
+				assert.Equal(t, &InvalidCommandError{Position: 2, Character: 'i', Suggestion: 'I'}, err)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+
+			// when
+			commands, err := tt.alphabet.convertStringToCommands(tt.unverifiedCommands)
+
+			//then
+			tt.asserts(commands, err)
+		})
+	}
+}
+
+func TestAlphabet_ParseOrientation(t *testing.T) {
+
+	testCases := []struct {
+		name        string
+		alphabet    Alphabet
+		value       string
+		expected    CardinalPoint
+		expectedErr bool
+	}{
+		{name: "English West", alphabet: EnglishAlphabet, value: "W", expected: West},
+		{name: "Spanish West", alphabet: SpanishAlphabet, value: "O", expected: West},
+		{name: "English letter in Spanish", alphabet: SpanishAlphabet, value: "W", expectedErr: true},
+		{name: "Lowercase by default", alphabet: EnglishAlphabet, value: "n", expectedErr: true},
+		{name: "Lowercase when enabled", alphabet: SpanishAlphabet.WithLowercase(), value: "o", expected: West},
+		{name: "Empty", alphabet: EnglishAlphabet, value: "", expectedErr: true},
+		{name: "Long string", alphabet: EnglishAlphabet, value: "North", expectedErr: true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+
+			// when
+			orientation, err := tt.alphabet.ParseOrientation(tt.value)
+
+			//then
+			if tt.expectedErr {
+				assert.ErrorIs(t, err, ErrInvalidOrientation)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, orientation)
+		})
+	}
+}
+
+func TestAlphabet_Letter(t *testing.T) {
+
+	assert.Equal(t, "W", EnglishAlphabet.Letter(West))
+	assert.Equal(t, "O", SpanishAlphabet.Letter(West))
+	assert.Equal(t, "N", SpanishAlphabet.Letter(North))
+
+	withBothCases := Alphabet{Orientations: map[rune]CardinalPoint{'n': North, 'N': North}}
+	assert.Equal(t, "N", withBothCases.Letter(North))
+	assert.Equal(t, "S", withBothCases.Letter(South))
+}
+
+func TestAlphabet_CommandLetter(t *testing.T) {
+
+	assert.Equal(t, "R", EnglishAlphabet.CommandLetter(Right))
+	assert.Equal(t, "D", SpanishAlphabet.CommandLetter(Right))
+	assert.Equal(t, "AIAD", SpanishAlphabet.LocalizeCommands("ALAR"))
+	assert.Equal(t, "", SpanishAlphabet.LocalizeCommands(""))
+
+	withBothCases := Alphabet{Commands: map[rune]Command{'a': Advance, 'A': Advance}}
+	assert.Equal(t, "A", withBothCases.CommandLetter(Advance))
+	assert.Equal(t, "L", withBothCases.CommandLetter(Left))
+}
+
+func TestSpanishTravel(t *testing.T) {
+	//Given
+	rover := NewRover(NewMap(4, 5))
+	rover.UseAlphabet(SpanishAlphabet)
+
+	//When
+	output, err := rover.Travel(0, 0, East, "AAIAADAIA")
+
+	//Then
+	assert.Nil(t, err)
+	assert.Equal(t, "Verdadero, N, (3,3)", output)
+
+	//When
+	output, err = rover.Travel(0, 0, North, "DDA")
+
+	//Then
+	assert.Nil(t, err)
+	assert.Equal(t, "Falso, S, (0,0)", output)
+
+	//When
+	output, err = rover.Travel(3, 0, West, "A")
+
+	//Then
+	assert.Nil(t, err)
+	assert.Equal(t, "Verdadero, O, (2,0)", output)
+
+	//When
+	rover.UseAlphabet(EnglishAlphabet.WithLowercase())
+	output, err = rover.Travel(3, 0, West, "a")
+
+	//Then
+	assert.Nil(t, err)
+	assert.Equal(t, "True, W, (2,0)", output)
+}
+
+func TestSpanishTravel_FacingWest(t *testing.T) {
+	//Given
+	rover := NewRover(NewMap(5, 5))
+	rover.UseAlphabet(SpanishAlphabet)
+
+	//When
+	result, err := rover.Execute(2, 2, North, "I")
+
+	//Then
+	assert.Nil(t, err)
+	assert.Equal(t, Pose{2, 2, West}, result.Pose)
+
+	//When
+	pose := rover.Pose()
+	result, err = rover.Execute(pose.X, pose.Y, pose.Orientation, "A")
+
+	//Then
+	assert.Nil(t, err)
+	assert.Equal(t, Pose{1, 2, West}, result.Pose)
+
+	//When
+	_, err = rover.Execute(pose.X, pose.Y, "O", "A")
+
+	//Then
+	assert.ErrorIs(t, err, ErrInvalidOrientation)
+}
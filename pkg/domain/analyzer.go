@@ -0,0 +1,214 @@
+package rover
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Kinds of PlanWarning.
+const (
+	CancellingTurnsWarning = "cancelling-turns"
+	RedundantTurnsWarning  = "redundant-turns"
+	TrailingTurnsWarning   = "trailing-turns"
+	RevisitWarning         = "revisit"
+	NoAdvanceWarning       = "no-advance"
+)
+
+// PlanWarning points out a wasteful part of a list of commands. Position is the 1-based position of the first
+// command concerned.
+type PlanWarning struct {
+	Kind     string `json:"kind"`
+	Position int    `json:"position"`
+	Message  string `json:"message"`
+}
+
+// PlanAnalysis is the outcome of analyzing a list of commands. Optimized is the shortest list of commands that
+// visits the same cells in the same order and ends with the same pose, whatever the start pose.
+// NoOp is set when the plan has no effect at all, like LR or LLLL. Optimized is then empty, and as Travel rejects
+// empty lists of commands there is nothing to send to the Rover.
+type PlanAnalysis struct {
+	Commands  string        `json:"commands"`
+	Optimized string        `json:"optimized"`
+	NoOp      bool          `json:"noop,omitempty"`
+	Warnings  []PlanWarning `json:"warnings,omitempty"`
+}
+
+// AnalyzeCommands analyzes a list of commands written with the English alphabet. See Alphabet.AnalyzeCommands.
+func AnalyzeCommands(listOfCommands string) (PlanAnalysis, error) {
+	return EnglishAlphabet.AnalyzeCommands(listOfCommands)
+}
+
+// AnalyzeCommands normalizes and minimizes a list of commands, and reports its wasteful parts.
+// Every run of turns between two advances is replaced by its net rotation: nothing, R, RR or L. The optimized
+// list is checked to end with the same pose as the original from every orientation on an unbounded plane, after
+// visiting the same cells. It is written with the Alphabet's letters.
+func (a Alphabet) AnalyzeCommands(listOfCommands string) (PlanAnalysis, error) {
+
+	commands, err := a.convertStringToCommands(listOfCommands)
+	if err != nil {
+		return PlanAnalysis{}, err
+	}
+
+	analysis := PlanAnalysis{Commands: listOfCommands, Warnings: []PlanWarning{}}
+	optimized := make([]Command, 0, len(commands))
+
+	for start := 0; start < len(commands); {
+		if commands[start] == Advance {
+			optimized = append(optimized, Advance)
+			start++
+			continue
+		}
+
+		end := start
+		for end < len(commands) && commands[end] != Advance {
+			end++
+		}
+
+		net := netTurns(commands[start:end])
+		optimized = append(optimized, net...)
+		analysis.Warnings = append(analysis.Warnings, turnWarning(start, end, end == len(commands), net, a.writeCommands(net))...)
+		start = end
+	}
+
+	analysis.Warnings = append(analysis.Warnings, pathWarnings(commands)...)
+
+	if !sameTravel(commands, optimized) {
+		// Can not happen as turns are only merged with their neighbours, but the original is always safe.
+		optimized = commands
+	}
+
+	analysis.Optimized = a.writeCommands(optimized)
+	analysis.NoOp = len(optimized) == 0
+	return analysis, nil
+}
+
+// netTurns returns the shortest list of turns with the same effect as the given ones.
+func netTurns(turns []Command) []Command {
+
+	rotation := 0
+	for _, turn := range turns {
+		if turn == Right {
+			rotation++
+		} else {
+			rotation += 3
+		}
+	}
+
+	switch rotation % 4 {
+	case 1:
+		return []Command{Right}
+	case 2:
+		return []Command{Right, Right}
+	case 3:
+		return []Command{Left}
+	}
+
+	return nil
+}
+
+// turnWarning reports a run of turns from start to end, exclusive, that is longer than its net rotation.
+func turnWarning(start, end int, trailing bool, net []Command, replacement string) []PlanWarning {
+
+	if end-start <= len(net) {
+		return nil
+	}
+
+	switch {
+	case len(net) == 0 && trailing:
+		return []PlanWarning{{Kind: TrailingTurnsWarning, Position: start + 1, Message: "ends with turns that have no effect"}}
+	case len(net) == 0:
+		return []PlanWarning{{
+			Kind:     CancellingTurnsWarning,
+			Position: start + 1,
+			Message:  fmt.Sprintf("turns at positions %v to %v cancel each other out", start+1, end),
+		}}
+	}
+
+	return []PlanWarning{{
+		Kind:     RedundantTurnsWarning,
+		Position: start + 1,
+		Message:  fmt.Sprintf("turns at positions %v to %v can be replaced by %v", start+1, end, replacement),
+	}}
+}
+
+// pathWarnings reports the advances that go back to a cell already visited, and a list without advances.
+func pathWarnings(commands []Command) []PlanWarning {
+
+	warnings := []PlanWarning{}
+	pose := Pose{Orientation: North}
+	visited := map[Coordinate]int{{}: 0}
+	advances := 0
+
+	for i, command := range commands {
+		pose = pose.next(command)
+		if command != Advance {
+			continue
+		}
+
+		advances++
+		cell := Coordinate{X: pose.X, Y: pose.Y}
+		if first, ok := visited[cell]; ok {
+			message := fmt.Sprintf("advance at position %v revisits the cell reached at position %v", i+1, first)
+			if first == 0 {
+				message = fmt.Sprintf("advance at position %v revisits the start cell", i+1)
+			}
+			warnings = append(warnings, PlanWarning{Kind: RevisitWarning, Position: i + 1, Message: message})
+			continue
+		}
+		visited[cell] = i + 1
+	}
+
+	if advances == 0 {
+		warnings = append(warnings, PlanWarning{Kind: NoAdvanceWarning, Position: 1, Message: "never advances"})
+	}
+
+	return warnings
+}
+
+// sameTravel checks that both lists of commands visit the same cells in the same order and end with the same
+// pose on an unbounded plane, from every orientation.
+func sameTravel(original, optimized []Command) bool {
+
+	for _, orientation := range clockwise {
+		originalCells, originalEnd := travelOnPlane(Pose{Orientation: orientation}, original)
+		optimizedCells, optimizedEnd := travelOnPlane(Pose{Orientation: orientation}, optimized)
+
+		if originalEnd != optimizedEnd || len(originalCells) != len(optimizedCells) {
+			return false
+		}
+
+		for i := range originalCells {
+			if originalCells[i] != optimizedCells[i] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// travelOnPlane returns the cells reached by every advance and the final pose on an unbounded plane.
+func travelOnPlane(start Pose, commands []Command) ([]Coordinate, Pose) {
+
+	cells := []Coordinate{}
+	pose := start
+	for _, command := range commands {
+		pose = pose.next(command)
+		if command == Advance {
+			cells = append(cells, Coordinate{X: pose.X, Y: pose.Y})
+		}
+	}
+
+	return cells, pose
+}
+
+// writeCommands writes the commands with the Alphabet's letters.
+func (a Alphabet) writeCommands(commands []Command) string {
+
+	var sb strings.Builder
+	for _, c := range commands {
+		sb.WriteString(a.CommandLetter(c))
+	}
+
+	return sb.String()
+}
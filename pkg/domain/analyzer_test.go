@@ -0,0 +1,173 @@
+package rover
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyzeCommands(t *testing.T) {
+
+	testCases := []struct {
+		name           string
+		listOfCommands string
+		asserts        func(analysis PlanAnalysis, err error)
+	}{
+		{
+			name:           "Nothing to improve",
+			listOfCommands: "AARAALA",
+			asserts: func(analysis PlanAnalysis, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, PlanAnalysis{Commands: "AARAALA", Optimized: "AARAALA", Warnings: []PlanWarning{}}, analysis)
+			},
+		},
+		{
+			name:           "Three rights are a left",
+			listOfCommands: "ARRRA",
+			asserts: func(analysis PlanAnalysis, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, "ALA", analysis.Optimized)
+				assert.Equal(t, []PlanWarning{{Kind: RedundantTurnsWarning, Position: 2, Message: "turns at positions 2 to 4 can be replaced by L"}}, analysis.Warnings)
+			},
+		},
+		{
+			name:           "Turn pairs cancel out",
+			listOfCommands: "ALRALLLLA",
+			asserts: func(analysis PlanAnalysis, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, "AAA", analysis.Optimized)
+				assert.Equal(t, []PlanWarning{
+					{Kind: CancellingTurnsWarning, Position: 2, Message: "turns at positions 2 to 3 cancel each other out"},
+					{Kind: CancellingTurnsWarning, Position: 5, Message: "turns at positions 5 to 8 cancel each other out"},
+				}, analysis.Warnings)
+			},
+		},
+		{
+			name:           "Half turns are normalized",
+			listOfCommands: "ALLA",
+			asserts: func(analysis PlanAnalysis, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, "ARRA", analysis.Optimized)
+				assert.Len(t, analysis.Warnings, 1)
+				assert.Equal(t, RevisitWarning, analysis.Warnings[0].Kind)
+			},
+		},
+		{
+			name:           "Ends with turns that have no effect",
+			listOfCommands: "AALRRL",
+			asserts: func(analysis PlanAnalysis, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, "AA", analysis.Optimized)
+				assert.Equal(t, []PlanWarning{{Kind: TrailingTurnsWarning, Position: 3, Message: "ends with turns that have no effect"}}, analysis.Warnings)
+			},
+		},
+		{
+			name:           "Revisits cells",
+			listOfCommands: "AARRAARRA",
+			asserts: func(analysis PlanAnalysis, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, []PlanWarning{
+					{Kind: RevisitWarning, Position: 5, Message: "advance at position 5 revisits the cell reached at position 1"},
+					{Kind: RevisitWarning, Position: 6, Message: "advance at position 6 revisits the start cell"},
+					{Kind: RevisitWarning, Position: 9, Message: "advance at position 9 revisits the cell reached at position 1"},
+				}, analysis.Warnings)
+			},
+		},
+		{
+			name:           "Only turns",
+			listOfCommands: "RRRRR",
+			asserts: func(analysis PlanAnalysis, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, "R", analysis.Optimized)
+				assert.Equal(t, []PlanWarning{
+					{Kind: RedundantTurnsWarning, Position: 1, Message: "turns at positions 1 to 5 can be replaced by R"},
+					{Kind: NoAdvanceWarning, Position: 1, Message: "never advances"},
+				}, analysis.Warnings)
+			},
+		},
+		{
+			name:           "Plan without effect",
+			listOfCommands: "LR",
+			asserts: func(analysis PlanAnalysis, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, PlanAnalysis{
+					Commands:  "LR",
+					Optimized: "",
+					NoOp:      true,
+					Warnings: []PlanWarning{
+						{Kind: TrailingTurnsWarning, Position: 1, Message: "ends with turns that have no effect"},
+						{Kind: NoAdvanceWarning, Position: 1, Message: "never advances"},
+					},
+				}, analysis)
+			},
+		},
+		{
+			name:           "Full turn without effect",
+			listOfCommands: "LLLL",
+			asserts: func(analysis PlanAnalysis, err error) {
+				assert.Nil(t, err)
+				assert.True(t, analysis.NoOp)
+				assert.Empty(t, analysis.Optimized)
+			},
+		},
+		{
+			name:           "Invalid commands",
+			listOfCommands: "AXA",
+			asserts: func(analysis PlanAnalysis, err error) {
+				assert.ErrorIs(t, err, ErrInvalidCommand)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			// when
+			analysis, err := AnalyzeCommands(tc.listOfCommands)
+
+			//then
+			tc.asserts(analysis, err)
+		})
+	}
+}
+
+func TestAlphabet_AnalyzeCommands(t *testing.T) {
+
+	// when
+	analysis, err := SpanishAlphabet.WithLowercase().AnalyzeCommands("addda")
+
+	//then
+	assert.Nil(t, err)
+	assert.Equal(t, "AIA", analysis.Optimized)
+	assert.Equal(t, "turns at positions 2 to 4 can be replaced by I", analysis.Warnings[0].Message)
+}
+
+func TestAnalyzeCommands_SameTravel(t *testing.T) {
+
+	plans := []string{"A", "LRLRA", "ARRRARRRARRRA", "LLLLLLLAAARRRRRRL", "RALALALALA", "AARRLLLLAALRLRLRR"}
+
+	for _, plan := range plans {
+		t.Run(plan, func(t *testing.T) {
+
+			// given
+			r := NewRover(NewUnboundedSparseMap())
+			analysis, err := AnalyzeCommands(plan)
+			assert.Nil(t, err)
+
+			for _, orientation := range clockwise {
+				// when
+				original, err := r.Execute(0, 0, orientation, plan)
+				assert.Nil(t, err)
+				optimized := original
+				if analysis.Optimized != "" {
+					optimized, err = r.Execute(0, 0, orientation, analysis.Optimized)
+					assert.Nil(t, err)
+				}
+
+				//then
+				assert.Equal(t, original, optimized)
+				assert.LessOrEqual(t, len(analysis.Optimized), len(plan))
+			}
+		})
+	}
+}
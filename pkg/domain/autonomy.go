@@ -0,0 +1,87 @@
+package rover
+
+// Detour is the way around a hazard taken by an autonomous Rover. Step is the 1-based position of the Advance
+// that was blocked, Blocked the cell it would have entered and Rejoin the position of the command after which
+// the Rover is back on its intended route. Commands are the commands executed instead of those in between.
+type Detour struct {
+	Step     int        `json:"step" yaml:"step"`
+	Blocked  Coordinate `json:"blocked" yaml:"blocked"`
+	Rejoin   int        `json:"rejoin" yaml:"rejoin"`
+	Commands string     `json:"commands" yaml:"commands"`
+}
+
+// EnableAutonomy makes the Rover go around hazards instead of stopping. When an Advance is blocked, the Rover
+// looks for the closest point of its intended route it can reach with at most budget advances, goes there and
+// carries on with the rest of the commands. A budget of 0 or less disables autonomy.
+func (r *Rover) EnableAutonomy(budget int) {
+	r.autonomyBudget = budget
+}
+
+// planDetour finds how to rejoin the intended route after the Advance at position i of the commands was blocked.
+func (r *Rover) planDetour(commands []Command, i int) (Detour, bool) {
+
+	if r.autonomyBudget <= 0 {
+		return Detour{}, false
+	}
+
+	start := r.Pose()
+	origin := Coordinate{X: start.X, Y: start.Y}
+	budget := r.autonomyBudget
+	withinBudget := func(c Coordinate) bool {
+		return abs(c.X-origin.X)+abs(c.Y-origin.Y) <= budget
+	}
+
+	// The intended route is followed on an unbounded plane, and every cell it enters that the Rover is allowed to
+	// be at is a place where the Rover can rejoin it, the earliest being preferred.
+	intended := start.next(commands[i])
+	blocked := Coordinate{X: intended.X, Y: intended.Y}
+	for j := i + 1; j < len(commands); j++ {
+		intended = intended.next(commands[j])
+		target := Coordinate{X: intended.X, Y: intended.Y}
+
+		if commands[j] != Advance || !withinBudget(target) || !r.canEnter(target.X, target.Y) {
+			continue
+		}
+
+		path := findPath(planetaryMapFunc(r.canEnter), origin, func(c Coordinate) bool { return c == target }, withinBudget)
+		if path == nil || len(path)-1 > budget {
+			continue
+		}
+
+		detourCommands, end := commandsAlongPath(start, path)
+		detourCommands += turnsBetween(end.Orientation, intended.Orientation)
+
+		return Detour{Step: i + 1, Blocked: blocked, Rejoin: j + 1, Commands: detourCommands}, true
+	}
+
+	return Detour{}, false
+}
+
+// followDetour executes the commands of the detour. It returns false if any of its advances is blocked, which
+// can only happen when the map changes while travelling.
+func (r *Rover) followDetour(detour Detour) bool {
+
+	for _, c := range detour.Commands {
+		command := Command(string(c))
+		switch command {
+		case Left:
+			r.TurnLeft()
+		case Right:
+			r.TurnRight()
+		case Advance:
+			if err := r.Advance(); err != nil {
+				return false
+			}
+		}
+		r.notifyEvent(StepEvent{Step: detour.Step, Command: command, Accepted: true, Detour: true})
+	}
+
+	return true
+}
+
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
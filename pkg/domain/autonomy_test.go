@@ -0,0 +1,165 @@
+package rover
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAutonomy(t *testing.T) {
+
+	// Rock at (2,0) and a wall from (3,2) to (3,4):
+	//
+	//	4 ...#.
+	//	3 ...#.
+	//	2 ...#.
+	//	1 .....
+	//	0 ..#..
+	//	  01234
+	pm := NewSparseMap(5, 5)
+	pm.SetObstacle(2, 0)
+	pm.SetObstacle(3, 2)
+	pm.SetObstacle(3, 3)
+	pm.SetObstacle(3, 4)
+
+	testCases := []struct {
+		name           string
+		budget         int
+		initial        Pose
+		listOfCommands string
+		asserts        func(result TravelResult, err error)
+	}{
+		{
+			name:           "Autonomy disabled stops at the rock",
+			budget:         0,
+			initial:        Pose{0, 0, East},
+			listOfCommands: "AAAA",
+			asserts: func(result TravelResult, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, TravelResult{Valid: false, Pose: Pose{1, 0, East}}, result)
+			},
+		},
+		{
+			name:           "Goes around the rock",
+			budget:         4,
+			initial:        Pose{0, 0, East},
+			listOfCommands: "AAAA",
+			asserts: func(result TravelResult, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, TravelResult{
+					Valid:   true,
+					Pose:    Pose{4, 0, East},
+					Detours: []Detour{{Step: 2, Blocked: Coordinate{2, 0}, Rejoin: 3, Commands: "LARAARAL"}},
+				}, result)
+			},
+		},
+		{
+			name:           "Detour longer than the budget",
+			budget:         3,
+			initial:        Pose{0, 0, East},
+			listOfCommands: "AAAA",
+			asserts: func(result TravelResult, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, TravelResult{Valid: false, Pose: Pose{1, 0, East}}, result)
+			},
+		},
+		{
+			name:           "Rejoins at a later point when the next cells are blocked",
+			budget:         8,
+			initial:        Pose{2, 3, East},
+			listOfCommands: "AAR",
+			asserts: func(result TravelResult, err error) {
+				assert.Nil(t, err)
+				assert.True(t, result.Valid)
+				assert.Equal(t, Pose{4, 3, South}, result.Pose)
+				assert.Len(t, result.Detours, 1)
+				assert.Equal(t, Coordinate{3, 3}, result.Detours[0].Blocked)
+				assert.Equal(t, 2, result.Detours[0].Rejoin)
+			},
+		},
+		{
+			name:           "Route leaving the map has no detour",
+			budget:         10,
+			initial:        Pose{0, 3, North},
+			listOfCommands: "AAA",
+			asserts: func(result TravelResult, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, TravelResult{Valid: false, Pose: Pose{0, 4, North}}, result)
+			},
+		},
+		{
+			name:           "Route coming back from the edge",
+			budget:         1,
+			initial:        Pose{0, 3, North},
+			listOfCommands: "AARRAA",
+			asserts: func(result TravelResult, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, TravelResult{
+					Valid:   true,
+					Pose:    Pose{0, 3, South},
+					Detours: []Detour{{Step: 2, Blocked: Coordinate{0, 5}, Rejoin: 5, Commands: "RR"}},
+				}, result)
+			},
+		},
+		{
+			name:           "Several detours",
+			budget:         4,
+			initial:        Pose{0, 0, East},
+			listOfCommands: "AAAALLAAAA",
+			asserts: func(result TravelResult, err error) {
+				assert.Nil(t, err)
+				assert.True(t, result.Valid)
+				assert.Equal(t, Pose{0, 0, West}, result.Pose)
+				assert.Len(t, result.Detours, 2)
+				assert.Equal(t, 2, result.Detours[0].Step)
+				assert.Equal(t, 8, result.Detours[1].Step)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			rv := NewRover(pm)
+			rv.EnableAutonomy(tt.budget)
+
+			// when
+			result, err := rv.Execute(tt.initial.X, tt.initial.Y, tt.initial.Orientation, tt.listOfCommands)
+
+			//then
+			tt.asserts(result, err)
+		})
+	}
+}
+
+func TestAutonomy_Events(t *testing.T) {
+	//Given
+	pm := NewSparseMap(3, 2)
+	pm.SetObstacle(1, 0)
+	rover := NewRover(pm)
+	rover.EnableAutonomy(4)
+	detourSteps := make([]StepEvent, 0)
+	rover.OnStep(func(event StepEvent) {
+		if event.Detour {
+			detourSteps = append(detourSteps, event)
+		}
+	})
+
+	//When
+	rover.UseFormatter(JSONFormatter{})
+	output, err := rover.Travel(0, 0, East, "AA")
+
+	//Then
+	assert.Nil(t, err)
+	assert.Equal(t, `{"valid":true,"pose":{"x":2,"y":0,"orientation":"E"},"detours":[{"step":1,"blocked":{"x":1,"y":0},"rejoin":2,"commands":"LARAARAL"}]}`, output)
+	assert.Len(t, detourSteps, 8)
+	assert.Equal(t, StepEvent{Step: 1, Command: Advance, Pose: Pose{0, 1, North}, Accepted: true, Detour: true}, detourSteps[1])
+}
+
+func TestPose_Next(t *testing.T) {
+
+	assert.Equal(t, Pose{0, 1, North}, Pose{0, 0, North}.next(Advance))
+	assert.Equal(t, Pose{-1, 0, West}, Pose{0, 0, West}.next(Advance))
+	assert.Equal(t, Pose{0, 0, West}, Pose{0, 0, North}.next(Left))
+	assert.Equal(t, Pose{0, 0, North}, Pose{0, 0, West}.next(Right))
+	assert.Equal(t, Pose{0, 0, "X"}, Pose{0, 0, "X"}.next(Advance))
+}
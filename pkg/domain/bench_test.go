@@ -0,0 +1,164 @@
+package rover
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// Benchmarks of parsing, travels and maps. The sparse map has its own in sparsemap_test.go. Run them with:
+//
+//	go test ./pkg/domain -run '^$' -bench . -benchmem
+
+// commandCounts are the lengths of the lists of commands, from 10^3 to 10^7.
+var commandCounts = []int{1_000, 10_000, 100_000, 1_000_000, 10_000_000}
+
+// mapSides are the sides of the square maps, from 10^3 to 10^6 cells wide.
+var mapSides = []int{1_000, 10_000, 100_000, 1_000_000}
+
+// squareCommands returns a list of n commands going round a 2x2 square, which is valid on any map from (0,0)
+// facing North.
+func squareCommands(n int) string {
+	return strings.Repeat("AR", n/2+1)[:n]
+}
+
+// benchmarkMaps returns one map of every kind, all of them about side cells wide and holding the cells around (0,0).
+func benchmarkMaps(side int) map[string]PlanetaryMap {
+
+	rectangles := NewRectangleSetMap(Rectangle{0, 0, side, side})
+	rectangles.Difference(Rectangle{side / 4, side / 4, side / 2, side / 2})
+	rectangles.Union(Rectangle{side / 3, side / 3, side / 3, side / 3})
+
+	// Only the cells around the start are known, which is where the travels go.
+	knowledge := NewKnowledgeMap(NewMap(side, side))
+	knowledge.Reveal(Coordinate{0, 0}, 4)
+
+	return map[string]PlanetaryMap{
+		"rectangle":  NewMap(side, side),
+		"polygon":    NewPolygonMap(Coordinate{0, 0}, Coordinate{side, 0}, Coordinate{side, side}, Coordinate{side / 2, side + side/2}, Coordinate{0, side}),
+		"rectangles": rectangles,
+		"sparse":     NewSparseMap(side, side),
+		"knowledge":  knowledge,
+	}
+}
+
+func BenchmarkConvertStringToCommands(b *testing.B) {
+
+	for _, n := range commandCounts {
+		b.Run(fmt.Sprintf("commands=%d", n), func(b *testing.B) {
+			listOfCommands := squareCommands(n)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := convertStringToCommands(listOfCommands); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkTravel(b *testing.B) {
+
+	for _, n := range commandCounts {
+		b.Run(fmt.Sprintf("commands=%d", n), func(b *testing.B) {
+			rover := NewRover(NewMap(10, 10))
+			listOfCommands := squareCommands(n)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := rover.Travel(0, 0, North, listOfCommands); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkAppendTravel(b *testing.B) {
+
+	for _, n := range commandCounts {
+		b.Run(fmt.Sprintf("commands=%d", n), func(b *testing.B) {
+			rover := NewRover(NewMap(10, 10))
+			listOfCommands := squareCommands(n)
+			buffer := make([]byte, 0, 64)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				var err error
+				if buffer, err = rover.AppendTravel(buffer[:0], 0, 0, North, listOfCommands); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkTravel_Maps(b *testing.B) {
+
+	listOfCommands := squareCommands(10_000)
+
+	for _, side := range mapSides {
+		for kind, m := range benchmarkMaps(side) {
+			b.Run(fmt.Sprintf("map=%v/side=%d", kind, side), func(b *testing.B) {
+				rover := NewRover(m)
+
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if _, err := rover.Travel(0, 0, North, listOfCommands); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkIsValid(b *testing.B) {
+
+	for _, side := range mapSides {
+		for kind, m := range benchmarkMaps(side) {
+			b.Run(fmt.Sprintf("map=%v/side=%d", kind, side), func(b *testing.B) {
+				// Some of the cells are outside the map, so both answers are measured.
+				random := rand.New(rand.NewSource(1))
+				xs := make([]int, 1024)
+				ys := make([]int, 1024)
+				for i := range xs {
+					xs[i], ys[i] = random.Intn(side+side/4)-side/8, random.Intn(side+side/4)-side/8
+				}
+
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					m.IsValid(xs[i&1023], ys[i&1023])
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkFormatters(b *testing.B) {
+
+	result := TravelResult{Valid: true, Pose: Pose{X: 12, Y: 34, Orientation: East}}
+
+	for _, name := range FormatterNames() {
+		formatter, _ := LookupFormatter(name)
+		if localized, ok := formatter.(LocalizedFormatter); ok {
+			formatter = localized.Localize(EnglishAlphabet)
+		}
+
+		b.Run(fmt.Sprintf("format=%v", name), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := formatter.Format(result); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package rover
+
+import (
+	"math/rand"
+	"time"
+)
+
+// LinkConfig configures the communication between Earth and a SimulatedRover. LightTime is the one way delay of
+// every packet and PacketLoss the probability, from 0 to 1, of a packet being lost in either direction.
+// Packet losses are drawn from a random source created with Seed, so the same configuration always loses the
+// same packets.
+type LinkConfig struct {
+	LightTime  time.Duration
+	PacketLoss float64
+	Seed       int64
+}
+
+// Uplink is a list of commands sent from Earth. ArrivedAt is zero while the packet is travelling and when it was
+// lost.
+type Uplink struct {
+	Commands  string
+	SentAt    time.Time
+	ArrivedAt time.Time
+	Lost      bool
+}
+
+// Telemetry is a step of the Rover as it is received on Earth.
+type Telemetry struct {
+	ReceivedAt time.Time
+	TimedStep
+}
+
+// CommLink simulates the uplink of commands to a SimulatedRover and the downlink of its telemetry.
+type CommLink struct {
+	simulation *Simulation
+	rover      *SimulatedRover
+	config     LinkConfig
+	random     *rand.Rand
+	uplinks    []*Uplink
+	telemetry  []Telemetry
+	lostSteps  int
+	remove     func()
+}
+
+// NewCommLink connects Earth with the SimulatedRover. From now on every step of the Rover is sent back to Earth,
+// until the link is closed.
+func NewCommLink(simulation *Simulation, rover *SimulatedRover, config LinkConfig) *CommLink {
+
+	if simulation == nil || rover == nil {
+		return nil
+	}
+
+	newLink := CommLink{
+		simulation: simulation,
+		rover:      rover,
+		config:     config,
+		random:     rand.New(rand.NewSource(config.Seed)),
+	}
+	newLink.remove = rover.OnStep(newLink.downlink)
+
+	return &newLink
+}
+
+// Close stops sending the steps of the Rover back to Earth. Telemetry already travelling still arrives.
+func (l *CommLink) Close() {
+	l.remove()
+}
+
+// Send transmits a list of commands to the Rover, which executes it when it arrives one light time later.
+// The list is validated before sending, as invalid lists would be rejected by the Rover anyway.
+func (l *CommLink) Send(listOfCommands string) error {
+
+	if err := l.rover.rover.alphabet.ValidateCommands(listOfCommands); err != nil {
+		return err
+	}
+
+	uplink := &Uplink{Commands: listOfCommands, SentAt: l.simulation.Now(), Lost: l.lose()}
+	l.uplinks = append(l.uplinks, uplink)
+
+	if uplink.Lost {
+		return nil
+	}
+
+	l.simulation.After(l.config.LightTime, func() {
+		uplink.ArrivedAt = l.simulation.Now()
+		_ = l.rover.Submit(uplink.Commands)
+	})
+
+	return nil
+}
+
+// Uplinks returns every list of commands sent so far.
+func (l *CommLink) Uplinks() []Uplink {
+
+	uplinks := make([]Uplink, 0, len(l.uplinks))
+	for _, uplink := range l.uplinks {
+		uplinks = append(uplinks, *uplink)
+	}
+
+	return uplinks
+}
+
+// Telemetry returns the steps of the Rover already received on Earth, in the order they arrived.
+func (l *CommLink) Telemetry() []Telemetry {
+	return append([]Telemetry(nil), l.telemetry...)
+}
+
+// LostTelemetry returns how many steps of the Rover were lost on their way to Earth.
+func (l *CommLink) LostTelemetry() int {
+	return l.lostSteps
+}
+
+// downlink sends a step of the Rover back to Earth.
+func (l *CommLink) downlink(step TimedStep) {
+
+	if l.lose() {
+		l.lostSteps++
+		return
+	}
+
+	l.simulation.After(l.config.LightTime, func() {
+		l.telemetry = append(l.telemetry, Telemetry{ReceivedAt: l.simulation.Now(), TimedStep: step})
+	})
+}
+
+// lose decides if a packet is lost.
+func (l *CommLink) lose() bool {
+	return l.config.PacketLoss > 0 && l.random.Float64() < l.config.PacketLoss
+}
@@ -0,0 +1,146 @@
+package rover
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewCommLink(t *testing.T) {
+
+	sim := NewSimulation(simulationStart)
+	sr, _ := sim.AddRover("perseverance", NewRover(NewMap(3, 3)), Pose{0, 0, North}, CommandDurations{})
+
+	assert.NotNil(t, NewCommLink(sim, sr, LinkConfig{}))
+	assert.Nil(t, NewCommLink(nil, sr, LinkConfig{}))
+	assert.Nil(t, NewCommLink(sim, nil, LinkConfig{}))
+}
+
+func TestCommLink_Delay(t *testing.T) {
+	//Given
+	lightTime := 12 * time.Minute
+	sim := NewSimulation(simulationStart)
+	sr, _ := sim.AddRover("perseverance", NewRover(NewMap(3, 3)), Pose{0, 0, North}, CommandDurations{Advance: time.Minute, Turn: 30 * time.Second})
+	link := NewCommLink(sim, sr, LinkConfig{LightTime: lightTime})
+
+	//When
+	assert.Nil(t, link.Send("AR"))
+	assert.ErrorIs(t, link.Send("AX"), ErrInvalidCommand)
+	sim.RunUntil(simulationStart.Add(lightTime - time.Second))
+
+	//Then
+	assert.Len(t, sr.Results(), 0, "Commands can not arrive before the light time")
+	assert.Equal(t, []Uplink{{Commands: "AR", SentAt: simulationStart}}, link.Uplinks())
+
+	//When
+	sim.Run()
+
+	//Then
+	arrival := simulationStart.Add(lightTime)
+	assert.Equal(t, []Uplink{{Commands: "AR", SentAt: simulationStart, ArrivedAt: arrival}}, link.Uplinks())
+	assert.Equal(t, []TimedResult{{Commands: "AR", Start: arrival, End: arrival.Add(90 * time.Second), TravelResult: TravelResult{Valid: true, Pose: Pose{0, 1, East}}}}, sr.Results())
+
+	telemetry := link.Telemetry()
+	assert.Len(t, telemetry, 2)
+	assert.Equal(t, arrival.Add(time.Minute), telemetry[0].Time)
+	assert.Equal(t, arrival.Add(time.Minute+lightTime), telemetry[0].ReceivedAt)
+	assert.Equal(t, Right, telemetry[1].Command)
+	assert.Equal(t, arrival.Add(90*time.Second+lightTime), telemetry[1].ReceivedAt)
+	assert.Equal(t, 0, link.LostTelemetry())
+}
+
+func TestCommLink_Close(t *testing.T) {
+	//Given
+	lightTime := time.Minute
+	sim := NewSimulation(simulationStart)
+	sr, _ := sim.AddRover("perseverance", NewRover(NewMap(3, 3)), Pose{0, 0, North}, CommandDurations{Advance: time.Second, Turn: time.Second})
+	link := NewCommLink(sim, sr, LinkConfig{LightTime: lightTime})
+	assert.Nil(t, sr.Submit("A"))
+	sim.RunUntil(simulationStart.Add(time.Second))
+
+	//When
+	link.Close()
+	assert.Nil(t, sr.Submit("R"))
+	sim.Run()
+
+	//Then
+	telemetry := link.Telemetry()
+	assert.Len(t, telemetry, 1, "Steps taken after closing the link must not be sent")
+	assert.Equal(t, simulationStart.Add(time.Second+lightTime), telemetry[0].ReceivedAt, "Telemetry already travelling must arrive")
+	assert.Len(t, sr.Results(), 2)
+}
+
+func TestCommLink_PacketLoss(t *testing.T) {
+
+	run := func(config LinkConfig) *CommLink {
+		sim := NewSimulation(simulationStart)
+		sr, _ := sim.AddRover("opportunity", NewRover(NewMap(10, 10)), Pose{0, 0, North}, CommandDurations{Advance: time.Minute, Turn: time.Minute})
+		link := NewCommLink(sim, sr, config)
+		for i := 0; i < 20; i++ {
+			_ = link.Send("RL")
+			sim.RunUntil(sim.Now().Add(time.Hour))
+		}
+		sim.Run()
+		return link
+	}
+
+	testCases := []struct {
+		name    string
+		config  LinkConfig
+		asserts func(link *CommLink)
+	}{
+		{
+			name:   "Everything is lost",
+			config: LinkConfig{LightTime: time.Minute, PacketLoss: 1},
+			asserts: func(link *CommLink) {
+				for _, uplink := range link.Uplinks() {
+					assert.True(t, uplink.Lost)
+					assert.True(t, uplink.ArrivedAt.IsZero())
+				}
+				assert.Len(t, link.Telemetry(), 0)
+			},
+		},
+		{
+			name:   "Some packets are lost",
+			config: LinkConfig{LightTime: time.Minute, PacketLoss: 0.3, Seed: 42},
+			asserts: func(link *CommLink) {
+				lost := 0
+				for _, uplink := range link.Uplinks() {
+					if uplink.Lost {
+						lost++
+					}
+				}
+				assert.Greater(t, lost, 0)
+				assert.Less(t, lost, 20)
+				arrived := 2 * (20 - lost)
+				assert.Equal(t, arrived, len(link.Telemetry())+link.LostTelemetry())
+				assert.Greater(t, link.LostTelemetry(), 0)
+			},
+		},
+		{
+			name:   "Same seed loses the same packets",
+			config: LinkConfig{LightTime: time.Minute, PacketLoss: 0.5, Seed: 7},
+			asserts: func(link *CommLink) {
+				again := run(LinkConfig{LightTime: time.Minute, PacketLoss: 0.5, Seed: 7})
+				assert.Equal(t, again.Uplinks(), link.Uplinks())
+				assert.Equal(t, again.Telemetry(), link.Telemetry())
+
+				other := run(LinkConfig{LightTime: time.Minute, PacketLoss: 0.5, Seed: 8})
+				assert.NotEqual(t, other.Uplinks(), link.Uplinks())
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+
+			// when
+			link := run(tt.config)
+
+			//then
+			assert.Len(t, link.Uplinks(), 20)
+			tt.asserts(link)
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package rover
+
+// CoverageReport summarizes the execution of a coverage plan.
+type CoverageReport struct {
+	Commands       string
+	Output         string
+	ReachableCells int
+	CoveredCells   int
+	Ratio          float64
+	PathLength     int
+}
+
+// PlanCoverage returns a list of commands that takes a Rover from the start pose through every cell reachable
+// on the map. Rectangular maps are swept row by row like a lawnmower, any other map is covered by repeatedly
+// going to the closest cell not visited yet.
+func PlanCoverage(m PlanetaryMap, start Pose) (string, error) {
+
+	bounds, err := coverageBounds(m, start)
+	if err != nil {
+		return "", err
+	}
+
+	_, isRectangle := m.(*Map)
+	commands, _ := commandsAlongPath(start, coveragePath(m, bounds, Coordinate{X: start.X, Y: start.Y}, isRectangle))
+
+	return commands, nil
+}
+
+// coverageBounds validates the map and the start pose of a coverage plan, and returns the bounds to cover.
+func coverageBounds(m PlanetaryMap, start Pose) (Rectangle, error) {
+
+	if m == nil {
+		return Rectangle{}, ErrMapNotInitialized
+	}
+
+	bounds, ok := MapBounds(m)
+	if !ok {
+		return Rectangle{}, ErrUnboundedMap
+	}
+
+	if err := validateStart(m, start); err != nil {
+		return Rectangle{}, err
+	}
+
+	return bounds, nil
+}
+
+// coveragePath returns the cells to visit, in order, to cover every cell of m within the bounds reachable from
+// the start.
+func coveragePath(m PlanetaryMap, bounds Rectangle, start Coordinate, lawnmower bool) []Coordinate {
+
+	if lawnmower {
+		return lawnmowerPath(m, bounds, start)
+	}
+
+	return closestFirstPath(m, bounds, start)
+}
+
+// lawnmowerPath goes to the corner closest to the start and then sweeps the rectangle row by row.
+func lawnmowerPath(m PlanetaryMap, bounds Rectangle, start Coordinate) []Coordinate {
+
+	left, right := bounds.X, bounds.X+bounds.Width-1
+	bottom, top := bounds.Y, bounds.Y+bounds.Height-1
+
+	corner := Coordinate{X: left, Y: bottom}
+	if right-start.X < start.X-left {
+		corner.X = right
+	}
+	if top-start.Y < start.Y-bottom {
+		corner.Y = top
+	}
+
+	path := findPath(m, start, func(c Coordinate) bool { return c == corner }, func(Coordinate) bool { return true })
+
+	stepX, stepY := 1, 1
+	if corner.X == right {
+		stepX = -1
+	}
+	if corner.Y == top {
+		stepY = -1
+	}
+
+	x := corner.X
+	for y := corner.Y; y >= bottom && y <= top; y += stepY {
+		if y != corner.Y {
+			path = append(path, Coordinate{X: x, Y: y})
+		}
+		for x+stepX >= left && x+stepX <= right {
+			x += stepX
+			path = append(path, Coordinate{X: x, Y: y})
+		}
+		stepX = -stepX
+	}
+
+	return path
+}
+
+// closestFirstPath visits every reachable cell going each time to the closest cell not visited yet.
+func closestFirstPath(m PlanetaryMap, bounds Rectangle, start Coordinate) []Coordinate {
+
+	inBounds := func(c Coordinate) bool { return bounds.Contains(c.X, c.Y) }
+	reachable := reachableCells(m, start, inBounds)
+
+	visited := map[Coordinate]bool{start: true}
+	path := []Coordinate{start}
+
+	for len(visited) < len(reachable) {
+		leg := findPath(m, path[len(path)-1], func(c Coordinate) bool { return !visited[c] }, inBounds)
+		for _, cell := range leg[1:] {
+			visited[cell] = true
+			path = append(path, cell)
+		}
+	}
+
+	return path
+}
+
+// Survey plans the coverage of the Rover's map from the start pose and executes it like Travel does, reporting
+// how many of the reachable cells were actually visited. Cells forbidden by the Rover's geofences are neither
+// visited nor counted as reachable.
+func (r *Rover) Survey(start Pose) (CoverageReport, error) {
+
+	if r == nil {
+		return CoverageReport{}, ErrRoverNotInitialized
+	}
+
+	bounds, err := coverageBounds(r.navigationMap, start)
+	if err != nil {
+		return CoverageReport{}, err
+	}
+
+	if g, violated := r.violatedGeofence(start.X, start.Y); violated {
+		return CoverageReport{}, &GeofenceError{Geofence: g.Name, X: start.X, Y: start.Y}
+	}
+
+	// The plan goes around the Rover's geofences, which also hide the cells only reachable through them. The
+	// lawnmower sweep only works on a whole rectangle.
+	allowed := planetaryMapFunc(r.canEnter)
+	_, isRectangle := r.navigationMap.(*Map)
+	origin := Coordinate{X: start.X, Y: start.Y}
+
+	commands, _ := commandsAlongPath(start, coveragePath(allowed, bounds, origin, isRectangle && len(r.geofences) == 0))
+	reachable := reachableCells(allowed, origin, func(c Coordinate) bool {
+		return bounds.Contains(c.X, c.Y)
+	})
+
+	report := CoverageReport{
+		Commands:       commands,
+		ReachableCells: len(reachable),
+	}
+
+	covered := map[Coordinate]bool{}
+	remove := r.OnStep(func(event StepEvent) {
+		if event.Accepted {
+			covered[Coordinate{X: event.Pose.X, Y: event.Pose.Y}] = true
+		}
+		if event.Command == Advance && event.Accepted {
+			report.PathLength++
+		}
+	})
+	defer remove()
+
+	// The plan is written with the English alphabet, so it is executed directly instead of being parsed again
+	// with the Rover's Alphabet.
+	report.Output, err = r.output.Format(r.run(start, canonicalCommands(commands)))
+	if err != nil {
+		return CoverageReport{}, err
+	}
+
+	report.CoveredCells = len(covered)
+	report.Ratio = float64(report.CoveredCells) / float64(report.ReachableCells)
+
+	return report, nil
+}
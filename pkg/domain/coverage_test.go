@@ -0,0 +1,216 @@
+package rover
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPlanCoverage(t *testing.T) {
+
+	withObstacles := NewSparseMap(4, 4)
+	withObstacles.SetObstacle(1, 1)
+	withObstacles.SetObstacle(2, 2)
+
+	testCases := []struct {
+		name         string
+		planetaryMap PlanetaryMap
+		start        Pose
+		asserts      func(commands string, err error)
+	}{
+		{
+			name:         "Rectangle from bottom left corner",
+			planetaryMap: NewMap(3, 2),
+			start:        Pose{0, 0, East},
+			asserts: func(commands string, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, "AALALAA", commands)
+			},
+		},
+		{
+			name:         "Rectangle from the middle goes to the closest corner first",
+			planetaryMap: NewMap(3, 3),
+			start:        Pose{2, 1, North},
+			asserts: func(commands string, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, "RRARAARARAALALAA", commands)
+			},
+		},
+		{
+			name:         "Single cell",
+			planetaryMap: NewMap(1, 1),
+			start:        Pose{0, 0, North},
+			asserts: func(commands string, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, "", commands)
+			},
+		},
+		{
+			name:         "Map without bounds",
+			planetaryMap: NewUnboundedSparseMap(),
+			start:        Pose{0, 0, North},
+			asserts: func(commands string, err error) {
+				assert.NotNil(t, err)
+			},
+		},
+		{
+			name:         "Invalid start",
+			planetaryMap: withObstacles,
+			start:        Pose{1, 1, North},
+			asserts: func(commands string, err error) {
+				assert.NotNil(t, err)
+			},
+		},
+		{
+			name:         "Invalid orientation",
+			planetaryMap: withObstacles,
+			start:        Pose{0, 0, "X"},
+			asserts: func(commands string, err error) {
+				assert.NotNil(t, err)
+			},
+		},
+		{
+			name:         "No planetary map present",
+			planetaryMap: nil,
+			start:        Pose{0, 0, North},
+			asserts: func(commands string, err error) {
+				assert.NotNil(t, err)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+
+			// when
+			commands, err := PlanCoverage(tt.planetaryMap, tt.start)
+
+			//then
+			tt.asserts(commands, err)
+		})
+	}
+}
+
+func TestSurvey(t *testing.T) {
+
+	withObstacles := NewSparseMap(5, 5)
+	withObstacles.SetObstacle(1, 1)
+	withObstacles.SetObstacle(2, 2)
+	withObstacles.SetObstacle(3, 1)
+	// Cell (4,4) can not be reached.
+	withObstacles.SetObstacle(3, 4)
+	withObstacles.SetObstacle(4, 3)
+
+	testCases := []struct {
+		name         string
+		planetaryMap PlanetaryMap
+		start        Pose
+		asserts      func(report CoverageReport, err error)
+	}{
+		{
+			name:         "Rectangle",
+			planetaryMap: NewMap(6, 4),
+			start:        Pose{3, 2, South},
+			asserts: func(report CoverageReport, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, 24, report.ReachableCells)
+				assert.Equal(t, 24, report.CoveredCells)
+				assert.Equal(t, 1.0, report.Ratio)
+				assert.Equal(t, 23+3, report.PathLength)
+				assert.Contains(t, report.Output, "True")
+			},
+		},
+		{
+			name:         "Polygon",
+			planetaryMap: NewPolygonMap(Coordinate{0, 0}, Coordinate{6, 0}, Coordinate{0, 6}),
+			start:        Pose{0, 0, North},
+			asserts: func(report CoverageReport, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, 15, report.ReachableCells)
+				assert.Equal(t, 15, report.CoveredCells)
+				assert.Equal(t, 1.0, report.Ratio)
+			},
+		},
+		{
+			name:         "Obstacles and unreachable cells",
+			planetaryMap: withObstacles,
+			start:        Pose{0, 0, East},
+			asserts: func(report CoverageReport, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, 19, report.ReachableCells)
+				assert.Equal(t, 19, report.CoveredCells)
+				assert.Equal(t, 1.0, report.Ratio)
+				assert.GreaterOrEqual(t, report.PathLength, 18)
+			},
+		},
+		{
+			name:         "Single cell",
+			planetaryMap: NewMap(1, 1),
+			start:        Pose{0, 0, West},
+			asserts: func(report CoverageReport, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, "True, W, (0,0)", report.Output)
+				assert.Equal(t, 1, report.CoveredCells)
+				assert.Equal(t, 0, report.PathLength)
+			},
+		},
+		{
+			name:         "Invalid start",
+			planetaryMap: NewMap(1, 1),
+			start:        Pose{1, 0, West},
+			asserts: func(report CoverageReport, err error) {
+				assert.NotNil(t, err)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			rv := NewRover(tt.planetaryMap)
+
+			// when
+			report, err := rv.Survey(tt.start)
+
+			//then
+			tt.asserts(report, err)
+			assert.Len(t, rv.listeners, 0, "Survey must remove its step listener")
+		})
+	}
+}
+
+func TestSurvey_Alphabet(t *testing.T) {
+	//Given
+	rover := NewRover(NewMap(3, 3))
+	rover.UseAlphabet(SpanishAlphabet)
+
+	//When
+	report, err := rover.Survey(Pose{0, 0, North})
+
+	//Then
+	assert.Nil(t, err, "The plan must run even if the rover does not use the English alphabet")
+	assert.Equal(t, 1.0, report.Ratio)
+	assert.Equal(t, "Verdadero, E, (2,2)", report.Output)
+}
+
+func TestSurvey_Geofences(t *testing.T) {
+	//Given
+	rover := NewRover(NewMap(4, 4))
+	rover.AddGeofences(NoGo("lander", NewRectangleSetMap(Rectangle{1, 1, 1, 1})))
+
+	//When
+	report, err := rover.Survey(Pose{0, 0, North})
+
+	//Then
+	assert.Nil(t, err)
+	assert.Contains(t, report.Output, "True")
+	assert.Equal(t, 15, report.ReachableCells)
+	assert.Equal(t, 15, report.CoveredCells)
+	assert.Equal(t, 1.0, report.Ratio)
+
+	//When
+	_, err = rover.Survey(Pose{1, 1, North})
+
+	//Then
+	assert.ErrorIs(t, err, ErrGeofenceViolation)
+}
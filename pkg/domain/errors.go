@@ -0,0 +1,107 @@
+package rover
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors returned by the package. Typed errors match their sentinel with errors.Is, so callers can check
+// the kind of problem without inspecting the details.
+var (
+	ErrRoverNotInitialized = errors.New("rover was not initialized")
+	ErrMapNotInitialized   = errors.New("planetary map was not initialized")
+	ErrEmptyCommands       = errors.New("list of commands is empty")
+	ErrUnboundedMap        = errors.New("map has no bounds")
+	ErrInvalidMapSpec      = errors.New("invalid map spec")
+	ErrInvalidCommand      = errors.New("invalid command")
+	ErrInvalidCoordinate   = errors.New("invalid coordinates")
+	ErrInvalidOrientation  = errors.New("invalid orientation")
+	ErrOutOfBounds         = errors.New("out of bounds")
+	ErrGeofenceViolation   = errors.New("geofence violation")
+	ErrInvalidFormatter    = errors.New("invalid formatter")
+	ErrFormatterExists     = errors.New("formatter already registered")
+)
+
+// InvalidCommandError is returned when a list of commands has a character that is not a Command.
+// Position is the 1-based position of the character in the list. Suggestion is what the character was probably
+// meant to be, written in the Alphabet in use like A for a, and it is 0 when there is no obvious fix. It is a letter and
+// not a Command, so it must be parsed with the Alphabet before it is sent to a Rover.
+type InvalidCommandError struct {
+	Position   int
+	Character  rune
+	Suggestion rune
+}
+
+func (e *InvalidCommandError) Error() string {
+
+	if e.Suggestion != 0 {
+		return fmt.Sprintf("%c at position %v is not a valid command, did you mean %c?", e.Character, e.Position, e.Suggestion)
+	}
+
+	return fmt.Sprintf("%c at position %v is not a valid command", e.Character, e.Position)
+}
+
+// Is makes the error match ErrInvalidCommand.
+func (e *InvalidCommandError) Is(target error) bool {
+	return target == ErrInvalidCommand
+}
+
+// InvalidCoordinateError is returned when a Rover is placed on a cell that is not valid on its map.
+type InvalidCoordinateError struct {
+	X int
+	Y int
+}
+
+func (e *InvalidCoordinateError) Error() string {
+	return fmt.Sprintf("(%v,%v) are not valid x and y coordinates", e.X, e.Y)
+}
+
+// Is makes the error match ErrInvalidCoordinate.
+func (e *InvalidCoordinateError) Is(target error) bool {
+	return target == ErrInvalidCoordinate
+}
+
+// InvalidOrientationError is returned when a value is not one of the four CardinalPoint.
+type InvalidOrientationError struct {
+	Orientation CardinalPoint
+}
+
+func (e *InvalidOrientationError) Error() string {
+	return fmt.Sprintf("%v is not a valid orientation", e.Orientation)
+}
+
+// Is makes the error match ErrInvalidOrientation.
+func (e *InvalidOrientationError) Is(target error) bool {
+	return target == ErrInvalidOrientation
+}
+
+// OutOfBoundsError is returned when a Rover can not advance to a cell because it is not valid on its map.
+type OutOfBoundsError struct {
+	X int
+	Y int
+}
+
+func (e *OutOfBoundsError) Error() string {
+	return fmt.Sprintf("can not advance to (%v,%v)", e.X, e.Y)
+}
+
+// Is makes the error match ErrOutOfBounds.
+func (e *OutOfBoundsError) Is(target error) bool {
+	return target == ErrOutOfBounds
+}
+
+// GeofenceError is returned when a Rover can not advance to a cell because a Geofence forbids it.
+type GeofenceError struct {
+	Geofence string
+	X        int
+	Y        int
+}
+
+func (e *GeofenceError) Error() string {
+	return fmt.Sprintf("geofence %v forbids advancing to (%v,%v)", e.Geofence, e.X, e.Y)
+}
+
+// Is makes the error match ErrGeofenceViolation.
+func (e *GeofenceError) Is(target error) bool {
+	return target == ErrGeofenceViolation
+}
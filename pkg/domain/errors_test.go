@@ -0,0 +1,138 @@
+package rover
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTravelErrors(t *testing.T) {
+
+	pMap := NewPlanetaryMap(4, 5)
+
+	testCases := []struct {
+		name               string
+		rover              *Rover
+		initialX           int
+		initialY           int
+		initialOrientation CardinalPoint
+		listOfCommands     string
+		asserts            func(err error)
+	}{
+		{
+			name:               "Rover not initialized",
+			rover:              nil,
+			initialOrientation: North,
+			listOfCommands:     "A",
+			asserts: func(err error) {
+				assert.ErrorIs(t, err, ErrRoverNotInitialized)
+				assert.Equal(t, "rover was not initialized", err.Error())
+			},
+		},
+		{
+			name:               "Empty list of commands",
+			rover:              NewRover(*pMap),
+			initialOrientation: North,
+			listOfCommands:     "",
+			asserts: func(err error) {
+				assert.ErrorIs(t, err, ErrEmptyCommands)
+			},
+		},
+		{
+			name:               "Invalid command",
+			rover:              NewRover(*pMap),
+			initialOrientation: North,
+			listOfCommands:     "AAñA",
+			asserts: func(err error) {
+				assert.ErrorIs(t, err, ErrInvalidCommand)
+
+				var commandErr *InvalidCommandError
+				assert.True(t, errors.As(err, &commandErr))
+				assert.Equal(t, 3, commandErr.Position)
+				assert.Equal(t, 'ñ', commandErr.Character)
+				assert.Equal(t, "ñ at position 3 is not a valid command", err.Error())
+			},
+		},
+		{
+			name:               "Invalid coordinates",
+			rover:              NewRover(*pMap),
+			initialX:           4,
+			initialY:           -1,
+			initialOrientation: North,
+			listOfCommands:     "A",
+			asserts: func(err error) {
+				assert.ErrorIs(t, err, ErrInvalidCoordinate)
+
+				var coordinateErr *InvalidCoordinateError
+				assert.True(t, errors.As(err, &coordinateErr))
+				assert.Equal(t, 4, coordinateErr.X)
+				assert.Equal(t, -1, coordinateErr.Y)
+				assert.Equal(t, "(4,-1) are not valid x and y coordinates", err.Error())
+			},
+		},
+		{
+			name:               "Invalid orientation",
+			rover:              NewRover(*pMap),
+			initialOrientation: "Wesr",
+			listOfCommands:     "A",
+			asserts: func(err error) {
+				assert.ErrorIs(t, err, ErrInvalidOrientation)
+
+				var orientationErr *InvalidOrientationError
+				assert.True(t, errors.As(err, &orientationErr))
+				assert.Equal(t, CardinalPoint("Wesr"), orientationErr.Orientation)
+				assert.NotErrorIs(t, err, ErrInvalidCoordinate)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+
+			// when
+			_, err := tt.rover.Travel(tt.initialX, tt.initialY, tt.initialOrientation, tt.listOfCommands)
+
+			//then
+			tt.asserts(err)
+		})
+	}
+}
+
+func TestAdvanceError(t *testing.T) {
+	//Given
+	rover := NewRover(NewMap(2, 2))
+	rover.currentOrientation = West
+
+	//When
+	err := rover.Advance()
+
+	//Then
+	assert.ErrorIs(t, err, ErrOutOfBounds)
+
+	var boundsErr *OutOfBoundsError
+	assert.True(t, errors.As(err, &boundsErr))
+	assert.Equal(t, -1, boundsErr.X)
+	assert.Equal(t, 0, boundsErr.Y)
+	assert.Equal(t, "can not advance to (-1,0)", err.Error())
+}
+
+func TestOtherErrors(t *testing.T) {
+
+	_, err := PlanCoverage(NewUnboundedSparseMap(), Pose{0, 0, North})
+	assert.ErrorIs(t, err, ErrUnboundedMap)
+
+	_, err = PlanCoverage(nil, Pose{0, 0, North})
+	assert.ErrorIs(t, err, ErrMapNotInitialized)
+
+	_, err = MapSpec{Kind: "hexagonal"}.Build()
+	assert.ErrorIs(t, err, ErrInvalidMapSpec)
+	assert.Equal(t, "invalid map spec: hexagonal is not a valid map kind", err.Error())
+
+	var rover *Rover
+	_, err = rover.Survey(Pose{0, 0, North})
+	assert.ErrorIs(t, err, ErrRoverNotInitialized)
+
+	_, err = rover.ExecuteWithin(0, 0, North, "A")
+	assert.ErrorIs(t, err, ErrRoverNotInitialized)
+}
@@ -0,0 +1,241 @@
+package rover
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TravelResult is the outcome of a Rover's travel. Valid is false when a command would have taken the Rover
+// out of the map or into a cell forbidden by a Geofence, and Pose is where the Rover ended. Geofence is the name
+// of the geofence that stopped the Rover, empty when it was the map. Detours lists the hazards an autonomous
+// Rover went around.
+type TravelResult struct {
+	Valid    bool     `json:"valid" yaml:"valid"`
+	Pose     Pose     `json:"pose" yaml:"pose"`
+	Geofence string   `json:"geofence,omitempty" yaml:"geofence,omitempty"`
+	Detours  []Detour `json:"detours,omitempty" yaml:"detours,omitempty"`
+}
+
+// Formatter turns a TravelResult into the text returned by Travel.
+type Formatter interface {
+	Format(result TravelResult) (string, error)
+}
+
+// FormatterFunc allows a plain function to be used as a Formatter.
+type FormatterFunc func(result TravelResult) (string, error)
+
+// Format calls the function.
+func (f FormatterFunc) Format(result TravelResult) (string, error) {
+	return f(result)
+}
+
+// LocalizedFormatter is implemented by formatters whose output depends on the Alphabet of the Rover using them.
+// Rovers call Localize with their Alphabet and use the returned Formatter.
+type LocalizedFormatter interface {
+	Formatter
+	Localize(alphabet Alphabet) Formatter
+}
+
+// AppendFormatter is implemented by formatters that can write a TravelResult into a caller's buffer, which
+// lets AppendTravel run without allocating.
+type AppendFormatter interface {
+	Formatter
+	AppendFormat(dst []byte, result TravelResult) []byte
+}
+
+// LegacyFormatter formats results like the original kata: "True, N, (1,4)".
+// Its words and orientation letters are worked out once, when it is created.
+type LegacyFormatter struct {
+	alphabet  Alphabet
+	trueWord  string
+	falseWord string
+	letters   map[CardinalPoint]string
+}
+
+// NewLegacyFormatter creates a LegacyFormatter whose words and orientation letters follow the Alphabet.
+func NewLegacyFormatter(alphabet Alphabet) *LegacyFormatter {
+
+	newFormatter := LegacyFormatter{alphabet: alphabet}
+	newFormatter.trueWord, newFormatter.falseWord = alphabet.outcomeWords()
+	newFormatter.letters = map[CardinalPoint]string{}
+	for _, cp := range []CardinalPoint{North, East, South, West} {
+		newFormatter.letters[cp] = alphabet.Letter(cp)
+	}
+
+	return &newFormatter
+}
+
+// Format will format the result to the requested specification.
+//
+//	-True, N, (1,4) when the final destination is within the map's limit.
+//	-False, N, (1,10) when the final destination falls out the map's limit.
+func (f *LegacyFormatter) Format(result TravelResult) (string, error) {
+
+	var buffer [64]byte
+	return string(f.AppendFormat(buffer[:0], result)), nil
+}
+
+// AppendFormat appends the formatted result to dst and returns the extended buffer.
+// Nothing is allocated when dst has enough room for the result.
+func (f *LegacyFormatter) AppendFormat(dst []byte, result TravelResult) []byte {
+
+	outcome := f.falseWord
+	if result.Valid {
+		outcome = f.trueWord
+	}
+
+	letter, ok := f.letters[result.Pose.Orientation]
+	if !ok {
+		letter = f.alphabet.Letter(result.Pose.Orientation)
+	}
+
+	dst = append(dst, outcome...)
+	dst = append(dst, ", "...)
+	dst = append(dst, letter...)
+	dst = append(dst, ", ("...)
+	dst = strconv.AppendInt(dst, int64(result.Pose.X), 10)
+	dst = append(dst, ',')
+	dst = strconv.AppendInt(dst, int64(result.Pose.Y), 10)
+
+	return append(dst, ')')
+}
+
+// Localize returns a LegacyFormatter for the Alphabet.
+func (f *LegacyFormatter) Localize(alphabet Alphabet) Formatter {
+	return NewLegacyFormatter(alphabet)
+}
+
+// JSONFormatter formats results as a JSON object.
+type JSONFormatter struct{}
+
+func (JSONFormatter) Format(result TravelResult) (string, error) {
+
+	content, err := json.Marshal(result)
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
+// YAMLFormatter formats results as a YAML document.
+type YAMLFormatter struct{}
+
+func (YAMLFormatter) Format(result TravelResult) (string, error) {
+
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(result); err != nil {
+		return "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
+
+// CSVFormatter formats results as a CSV row with the columns valid, x, y and orientation.
+type CSVFormatter struct{}
+
+func (CSVFormatter) Format(result TravelResult) (string, error) {
+
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	record := []string{
+		strconv.FormatBool(result.Valid),
+		strconv.Itoa(result.Pose.X),
+		strconv.Itoa(result.Pose.Y),
+		string(result.Pose.Orientation),
+	}
+
+	if err := writer.Write(record); err != nil {
+		return "", err
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
+
+// ClassicFormatter formats results as "x y O", the output used by most Mars Rover implementations.
+type ClassicFormatter struct{}
+
+func (ClassicFormatter) Format(result TravelResult) (string, error) {
+	return fmt.Sprintf("%v %v %v", result.Pose.X, result.Pose.Y, result.Pose.Orientation), nil
+}
+
+// Names of the built-in formatters.
+const (
+	LegacyFormat  = "legacy"
+	JSONFormat    = "json"
+	YAMLFormat    = "yaml"
+	CSVFormat     = "csv"
+	ClassicFormat = "classic"
+)
+
+var (
+	formattersMutex sync.RWMutex
+	formatters      = map[string]Formatter{
+		LegacyFormat:  NewLegacyFormatter(EnglishAlphabet),
+		JSONFormat:    JSONFormatter{},
+		YAMLFormat:    YAMLFormatter{},
+		CSVFormat:     CSVFormatter{},
+		ClassicFormat: ClassicFormatter{},
+	}
+)
+
+// RegisterFormatter makes a Formatter available by name to LookupFormatter, and so to the CLI and servers.
+// Names already registered, built-in ones included, can not be replaced.
+func RegisterFormatter(name string, formatter Formatter) error {
+
+	if name == "" || formatter == nil {
+		return fmt.Errorf("%w: a formatter needs a name and an implementation", ErrInvalidFormatter)
+	}
+
+	formattersMutex.Lock()
+	defer formattersMutex.Unlock()
+
+	if _, exists := formatters[name]; exists {
+		return fmt.Errorf("%w: %v", ErrFormatterExists, name)
+	}
+	formatters[name] = formatter
+
+	return nil
+}
+
+// LookupFormatter returns the Formatter registered with the name.
+func LookupFormatter(name string) (Formatter, bool) {
+
+	formattersMutex.RLock()
+	defer formattersMutex.RUnlock()
+
+	formatter, ok := formatters[name]
+	return formatter, ok
+}
+
+// FormatterNames returns the names of every registered Formatter in alphabetical order.
+func FormatterNames() []string {
+
+	formattersMutex.RLock()
+	defer formattersMutex.RUnlock()
+
+	names := make([]string, 0, len(formatters))
+	for name := range formatters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
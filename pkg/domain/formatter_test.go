@@ -0,0 +1,130 @@
+package rover
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFormatters(t *testing.T) {
+
+	valid := TravelResult{Valid: true, Pose: Pose{1, 4, North}}
+	invalid := TravelResult{Valid: false, Pose: Pose{3, 0, West}}
+
+	testCases := []struct {
+		name      string
+		formatter Formatter
+		result    TravelResult
+		expected  string
+	}{
+		{name: "Legacy", formatter: NewLegacyFormatter(EnglishAlphabet), result: valid, expected: "True, N, (1,4)"},
+		{name: "Legacy invalid", formatter: NewLegacyFormatter(EnglishAlphabet), result: invalid, expected: "False, W, (3,0)"},
+		{name: "Legacy Spanish", formatter: NewLegacyFormatter(SpanishAlphabet), result: invalid, expected: "Falso, O, (3,0)"},
+		{name: "JSON", formatter: JSONFormatter{}, result: valid, expected: `{"valid":true,"pose":{"x":1,"y":4,"orientation":"N"}}`},
+		{name: "YAML", formatter: YAMLFormatter{}, result: invalid, expected: "valid: false\npose:\n  x: 3\n  \"y\": 0\n  orientation: W"},
+		{name: "CSV", formatter: CSVFormatter{}, result: valid, expected: "true,1,4,N"},
+		{name: "Classic", formatter: ClassicFormatter{}, result: invalid, expected: "3 0 W"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+
+			// when
+			output, err := tt.formatter.Format(tt.result)
+
+			//then
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, output)
+		})
+	}
+}
+
+func TestFormatterRegistry(t *testing.T) {
+
+	for _, name := range []string{LegacyFormat, JSONFormat, YAMLFormat, CSVFormat, ClassicFormat} {
+		formatter, ok := LookupFormatter(name)
+		assert.True(t, ok, "Built-in formatter %v is not registered", name)
+		assert.NotNil(t, formatter)
+	}
+
+	_, ok := LookupFormatter("xml")
+	assert.False(t, ok)
+
+	custom := FormatterFunc(func(result TravelResult) (string, error) {
+		return "custom", nil
+	})
+	assert.Nil(t, RegisterFormatter("test-custom", custom))
+	assert.ErrorIs(t, RegisterFormatter("test-custom", custom), ErrFormatterExists, "Formatters can not be registered twice")
+	assert.ErrorIs(t, RegisterFormatter(JSONFormat, custom), ErrFormatterExists, "Built-in formatters can not be replaced")
+	assert.ErrorIs(t, RegisterFormatter("", custom), ErrInvalidFormatter)
+	assert.ErrorIs(t, RegisterFormatter("test-nil", nil), ErrInvalidFormatter)
+
+	formatter, ok := LookupFormatter("test-custom")
+	assert.True(t, ok)
+	output, err := formatter.Format(TravelResult{})
+	assert.Nil(t, err)
+	assert.Equal(t, "custom", output)
+	assert.Contains(t, FormatterNames(), "test-custom")
+	assert.Equal(t, "classic", FormatterNames()[0])
+}
+
+func TestUseFormatter(t *testing.T) {
+	//Given
+	rover := NewRover(NewMap(4, 5))
+
+	//When
+	rover.UseFormatter(ClassicFormatter{})
+	output, err := rover.Travel(0, 0, East, "AALA")
+
+	//Then
+	assert.Nil(t, err)
+	assert.Equal(t, "2 1 N", output)
+
+	//When
+	rover.UseFormatter(NewLegacyFormatter(EnglishAlphabet))
+	rover.UseAlphabet(SpanishAlphabet)
+	output, err = rover.Travel(0, 0, "E", "AAIA")
+
+	//Then
+	assert.Nil(t, err)
+	assert.Equal(t, "Verdadero, N, (2,1)", output, "Legacy formatter should be localized with the rover's alphabet")
+
+	//When
+	rover.UseFormatter(nil)
+	rover.UseAlphabet(EnglishAlphabet)
+	output, err = rover.Travel(0, 0, East, "RA")
+
+	//Then
+	assert.Nil(t, err)
+	assert.Equal(t, "False, S, (0,0)", output)
+
+	//When
+	rover.UseFormatter(FormatterFunc(func(TravelResult) (string, error) {
+		return "", errors.New("broken")
+	}))
+	output, err = rover.Travel(0, 0, East, "RA")
+
+	//Then
+	assert.EqualError(t, err, "broken")
+	assert.Equal(t, "", output)
+}
+
+func TestExecute(t *testing.T) {
+	//Given
+	rover := NewRover(NewMap(4, 5))
+
+	//When
+	result, err := rover.Execute(0, 0, East, "AALAARALAAA")
+
+	//Then
+	assert.Nil(t, err)
+	assert.Equal(t, TravelResult{Valid: false, Pose: Pose{3, 4, North}}, result)
+
+	//When
+	result, err = rover.Execute(0, 0, East, "AXA")
+
+	//Then
+	assert.ErrorIs(t, err, ErrInvalidCommand)
+	assert.Equal(t, TravelResult{}, result)
+}
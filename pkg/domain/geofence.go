@@ -0,0 +1,112 @@
+package rover
+
+// Geofence is a zone mission rules make the Rover stay in or out of, apart from the edges of its map.
+// Any PlanetaryMap can be the zone, usually a RectangleSetMap or a PolygonMap. An exclusion geofence forbids
+// advancing into the zone, and an inclusion geofence forbids advancing out of it.
+type Geofence struct {
+	Name    string
+	Zone    PlanetaryMap
+	Exclude bool
+}
+
+// GeofenceSpec describes a Geofence whose zone is a MapSpec, so that it can be written to files and logs.
+type GeofenceSpec struct {
+	Name    string  `json:"name"`
+	Zone    MapSpec `json:"zone"`
+	Exclude bool    `json:"exclude,omitempty"`
+}
+
+// Build creates the Geofence described by the spec.
+func (s GeofenceSpec) Build() (Geofence, error) {
+
+	zone, err := s.Zone.Build()
+	if err != nil {
+		return Geofence{}, err
+	}
+
+	return Geofence{Name: s.Name, Zone: zone, Exclude: s.Exclude}, nil
+}
+
+// KeepIn returns an inclusion Geofence.
+func KeepIn(name string, zone PlanetaryMap) Geofence {
+	return Geofence{Name: name, Zone: zone}
+}
+
+// NoGo returns an exclusion Geofence.
+func NoGo(name string, zone PlanetaryMap) Geofence {
+	return Geofence{Name: name, Zone: zone, Exclude: true}
+}
+
+// Forbids checks if the Geofence forbids the Rover to be at x and y coordinates.
+func (g Geofence) Forbids(xCoordinate, yCoordinate int) bool {
+	return g.Zone.IsValid(xCoordinate, yCoordinate) == g.Exclude
+}
+
+type registeredGeofence struct {
+	id       int
+	geofence Geofence
+}
+
+// AddGeofences attaches geofences to the Rover, checked on every Advance of the following travels until the
+// returned function removes them.
+func (r *Rover) AddGeofences(geofences ...Geofence) func() {
+
+	r.nextGeofenceID++
+	id := r.nextGeofenceID
+	for _, g := range geofences {
+		r.geofences = append(r.geofences, registeredGeofence{id: id, geofence: g})
+	}
+
+	return func() {
+		kept := r.geofences[:0:0]
+		for _, registered := range r.geofences {
+			if registered.id != id {
+				kept = append(kept, registered)
+			}
+		}
+		r.geofences = kept
+	}
+}
+
+// ExecuteWithin works like Execute, also checking the geofences on every Advance of this travel only, as the
+// rules of a mission phase.
+func (r *Rover) ExecuteWithin(initialX int, initialY int, initialOrientation CardinalPoint, listOfCommands string, geofences ...Geofence) (TravelResult, error) {
+
+	if r == nil {
+		return TravelResult{}, ErrRoverNotInitialized
+	}
+
+	r.travelGeofences = geofences
+	defer func() { r.travelGeofences = nil }()
+
+	return r.Execute(initialX, initialY, initialOrientation, listOfCommands)
+}
+
+// TravelWithin works like Travel, also checking the geofences on every Advance of this travel only.
+func (r *Rover) TravelWithin(initialX int, initialY int, initialOrientation CardinalPoint, listOfCommands string, geofences ...Geofence) (string, error) {
+
+	result, err := r.ExecuteWithin(initialX, initialY, initialOrientation, listOfCommands, geofences...)
+	if err != nil {
+		return "", err
+	}
+
+	return r.output.Format(result)
+}
+
+// violatedGeofence returns the first geofence of the Rover, or of the current travel, forbidding x and y.
+func (r *Rover) violatedGeofence(xCoordinate, yCoordinate int) (Geofence, bool) {
+
+	for _, registered := range r.geofences {
+		if registered.geofence.Forbids(xCoordinate, yCoordinate) {
+			return registered.geofence, true
+		}
+	}
+
+	for _, g := range r.travelGeofences {
+		if g.Forbids(xCoordinate, yCoordinate) {
+			return g, true
+		}
+	}
+
+	return Geofence{}, false
+}
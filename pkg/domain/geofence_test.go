@@ -0,0 +1,194 @@
+package rover
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeofence_Forbids(t *testing.T) {
+
+	zone := NewRectangleSetMap(Rectangle{1, 1, 2, 2})
+
+	testCases := []struct {
+		name     string
+		geofence Geofence
+		x        int
+		y        int
+		expected bool
+	}{
+		{name: "No-go zone inside", geofence: NoGo("lander", zone), x: 1, y: 2, expected: true},
+		{name: "No-go zone outside", geofence: NoGo("lander", zone), x: 0, y: 0, expected: false},
+		{name: "Keep-in zone inside", geofence: KeepIn("site", zone), x: 2, y: 2, expected: false},
+		{name: "Keep-in zone outside", geofence: KeepIn("site", zone), x: 3, y: 2, expected: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.geofence.Forbids(tc.x, tc.y))
+		})
+	}
+}
+
+func TestGeofences(t *testing.T) {
+
+	// Lander at (2,2) on a 5x5 map, and a triangular science site.
+	lander := NoGo("lander", NewRectangleSetMap(Rectangle{2, 2, 1, 1}))
+	site := KeepIn("site", NewPolygonMap(Coordinate{0, 0}, Coordinate{4, 0}, Coordinate{0, 4}))
+
+	testCases := []struct {
+		name           string
+		rover          func() *Rover
+		initial        Pose
+		listOfCommands string
+		phase          []Geofence
+		asserts        func(result TravelResult, err error)
+	}{
+		{
+			name: "Rover geofence stops the travel",
+			rover: func() *Rover {
+				r := NewRover(NewMap(5, 5))
+				r.AddGeofences(lander)
+				return r
+			},
+			initial:        Pose{2, 0, North},
+			listOfCommands: "AAA",
+			asserts: func(result TravelResult, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, TravelResult{Valid: false, Pose: Pose{2, 1, North}, Geofence: "lander"}, result)
+			},
+		},
+		{
+			name:           "Map edge has no geofence",
+			rover:          func() *Rover { return NewRover(NewMap(5, 5)) },
+			initial:        Pose{2, 3, North},
+			listOfCommands: "AA",
+			phase:          []Geofence{lander},
+			asserts: func(result TravelResult, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, TravelResult{Valid: false, Pose: Pose{2, 4, North}}, result)
+			},
+		},
+		{
+			name:           "Phase geofence keeps the rover in the site",
+			rover:          func() *Rover { return NewRover(NewMap(5, 5)) },
+			initial:        Pose{0, 0, East},
+			listOfCommands: "AAAA",
+			phase:          []Geofence{site},
+			asserts: func(result TravelResult, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, TravelResult{Valid: false, Pose: Pose{2, 0, East}, Geofence: "site"}, result)
+			},
+		},
+		{
+			name: "Removed geofence",
+			rover: func() *Rover {
+				r := NewRover(NewMap(5, 5))
+				remove := r.AddGeofences(lander, site)
+				remove()
+				return r
+			},
+			initial:        Pose{2, 0, North},
+			listOfCommands: "AAAA",
+			asserts: func(result TravelResult, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, TravelResult{Valid: true, Pose: Pose{2, 4, North}}, result)
+			},
+		},
+		{
+			name: "Autonomous rover goes around the lander",
+			rover: func() *Rover {
+				r := NewRover(NewMap(5, 5))
+				r.EnableAutonomy(4)
+				r.AddGeofences(lander)
+				return r
+			},
+			initial:        Pose{2, 1, North},
+			listOfCommands: "AA",
+			asserts: func(result TravelResult, err error) {
+				assert.Nil(t, err)
+				assert.True(t, result.Valid)
+				assert.Equal(t, Pose{2, 3, North}, result.Pose)
+				assert.Len(t, result.Detours, 1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			// given
+			r := tc.rover()
+
+			// when
+			result, err := r.ExecuteWithin(tc.initial.X, tc.initial.Y, tc.initial.Orientation, tc.listOfCommands, tc.phase...)
+
+			//then
+			tc.asserts(result, err)
+		})
+	}
+}
+
+func TestGeofences_OnlyForTheTravel(t *testing.T) {
+
+	// given
+	r := NewRover(NewMap(5, 5))
+	lander := NoGo("lander", NewRectangleSetMap(Rectangle{2, 2, 1, 1}))
+
+	// when
+	within, err := r.TravelWithin(2, 1, North, "A", lander)
+	assert.Nil(t, err)
+	after, err := r.Travel(2, 1, North, "A")
+	assert.Nil(t, err)
+
+	//then
+	assert.Equal(t, "False, N, (2,1)", within)
+	assert.Equal(t, "True, N, (2,2)", after)
+}
+
+func TestGeofences_Advance(t *testing.T) {
+
+	// given
+	r := NewRover(NewMap(5, 5))
+	r.AddGeofences(NoGo("lander", NewRectangleSetMap(Rectangle{2, 2, 1, 1})))
+	_, err := r.Execute(2, 1, North, "L")
+	assert.Nil(t, err)
+	r.TurnRight()
+
+	// when
+	err = r.Advance()
+
+	//then
+	assert.ErrorIs(t, err, ErrGeofenceViolation)
+	assert.EqualError(t, err, "geofence lander forbids advancing to (2,2)")
+	assert.Equal(t, Pose{2, 1, North}, r.Pose())
+}
+
+func TestGeofences_Mission(t *testing.T) {
+
+	// given
+	r := NewRover(NewMap(3, 3))
+	r.AddGeofences(NoGo("lander", NewRectangleSetMap(Rectangle{1, 0, 1, 2})))
+
+	// when
+	report, err := r.RunMission(Pose{0, 0, East}, Mission{Waypoints: []Waypoint{{X: 2, Y: 0}}})
+
+	//then
+	assert.Nil(t, err)
+	assert.True(t, report.Completed)
+	assert.Equal(t, "LAARAARAA", report.Legs[0].Commands)
+}
+
+func TestGeofences_Format(t *testing.T) {
+
+	// given
+	r := NewRover(NewMap(5, 5))
+	r.UseFormatter(JSONFormatter{})
+
+	// when
+	output, err := r.TravelWithin(2, 1, North, "A", NoGo("lander", NewRectangleSetMap(Rectangle{2, 2, 1, 1})))
+
+	//then
+	assert.Nil(t, err)
+	assert.Equal(t, `{"valid":false,"pose":{"x":2,"y":1,"orientation":"N"},"geofence":"lander"}`, output)
+}
@@ -0,0 +1,161 @@
+package rover
+
+import (
+	"strings"
+)
+
+// CellState is what a Rover believes about a cell of the map.
+type CellState int
+
+const (
+	Unknown CellState = iota
+	Free
+	Blocked
+)
+
+// KnowledgeMap is the map a Rover believes in while exploring. It starts empty and cells are revealed by the
+// Rover's sensor from the ground truth PlanetaryMap, which the Rover does not know in advance.
+type KnowledgeMap struct {
+	truth PlanetaryMap
+	cells map[Coordinate]CellState
+}
+
+// NewKnowledgeMap creates an empty believed map over the ground truth map.
+func NewKnowledgeMap(truth PlanetaryMap) *KnowledgeMap {
+
+	if truth == nil {
+		return nil
+	}
+
+	newMap := KnowledgeMap{
+		truth: truth,
+		cells: make(map[Coordinate]CellState),
+	}
+
+	return &newMap
+}
+
+// Reveal uncovers every cell whose distance to the centre is not greater than the radius.
+func (k *KnowledgeMap) Reveal(centre Coordinate, radius int) {
+
+	for dy := -radius; dy <= radius; dy++ {
+		for dx := -radius; dx <= radius; dx++ {
+			if dx*dx+dy*dy > radius*radius {
+				continue
+			}
+
+			cell := Coordinate{X: centre.X + dx, Y: centre.Y + dy}
+			if k.truth.IsValid(cell.X, cell.Y) {
+				k.cells[cell] = Free
+			} else {
+				k.cells[cell] = Blocked
+			}
+		}
+	}
+}
+
+// Cell returns what is known about the cell at x and y coordinates.
+func (k *KnowledgeMap) Cell(xCoordinate, yCoordinate int) CellState {
+	return k.cells[Coordinate{X: xCoordinate, Y: yCoordinate}]
+}
+
+// IsValid validates a pair of x and y coordinates against what the Rover believes, so only revealed free cells
+// are valid. This allows planning on the believed map as on any other PlanetaryMap.
+func (k *KnowledgeMap) IsValid(xCoordinate, yCoordinate int) bool {
+	return k.Cell(xCoordinate, yCoordinate) == Free
+}
+
+// Bounds returns the bounds of the ground truth map when it has them, otherwise the rectangle enclosing all the
+// revealed cells.
+func (k *KnowledgeMap) Bounds() (Rectangle, bool) {
+
+	if bounds, ok := MapBounds(k.truth); ok {
+		return bounds, true
+	}
+
+	bounds := Rectangle{}
+	for cell := range k.cells {
+		bounds = bounds.union(Rectangle{X: cell.X, Y: cell.Y, Width: 1, Height: 1})
+	}
+
+	return bounds, true
+}
+
+// ExploredPercentage returns the percentage of the ground truth map's valid cells already revealed. Cells outside
+// the map, obstacles and cells in the bounds that the map does not accept are not counted.
+// Maps without bounds have no meaningful percentage, so 0 is returned for them.
+func (k *KnowledgeMap) ExploredPercentage() float64 {
+
+	bounds, ok := MapBounds(k.truth)
+	if !ok || bounds.Width <= 0 || bounds.Height <= 0 {
+		return 0
+	}
+
+	valid := bounds.Width * bounds.Height
+	if _, rectangle := k.truth.(*Map); !rectangle {
+		valid = 0
+		for y := bounds.Y; y < bounds.Y+bounds.Height; y++ {
+			for x := bounds.X; x < bounds.X+bounds.Width; x++ {
+				if k.truth.IsValid(x, y) {
+					valid++
+				}
+			}
+		}
+	}
+
+	if valid == 0 {
+		return 0
+	}
+
+	explored := 0
+	for cell := range k.cells {
+		if bounds.Contains(cell.X, cell.Y) && k.truth.IsValid(cell.X, cell.Y) {
+			explored++
+		}
+	}
+
+	return float64(explored) * 100 / float64(valid)
+}
+
+// Render draws the believed map with the top row first.
+//
+//	-? for an unknown cell.
+//	-. for a free cell.
+//	-# for a blocked cell.
+func (k *KnowledgeMap) Render() string {
+
+	bounds, _ := k.Bounds()
+
+	var sb strings.Builder
+	for y := bounds.Y + bounds.Height - 1; y >= bounds.Y; y-- {
+		for x := bounds.X; x < bounds.X+bounds.Width; x++ {
+			switch k.Cell(x, y) {
+			case Free:
+				sb.WriteByte('.')
+			case Blocked:
+				sb.WriteByte('#')
+			default:
+				sb.WriteByte('?')
+			}
+		}
+		sb.WriteByte('\n')
+	}
+
+	return sb.String()
+}
+
+// EquipSensor gives the Rover a sensor with the given radius. From now on every travel reveals the cells around
+// the Rover on the returned believed map, which is kept separate from the Rover's navigation map.
+func (r *Rover) EquipSensor(radius int) *KnowledgeMap {
+
+	if r == nil {
+		return nil
+	}
+
+	knowledge := NewKnowledgeMap(r.navigationMap)
+	r.OnStep(func(event StepEvent) {
+		knowledge.Reveal(Coordinate{X: event.Pose.X, Y: event.Pose.Y}, radius)
+	})
+
+	return knowledge
+}
@@ -0,0 +1,171 @@
+package rover
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestKnowledgeMapConstructor(t *testing.T) {
+
+	km := NewKnowledgeMap(NewMap(4, 4))
+
+	assert.NotNil(t, km, "The new knowledge map method returned nil")
+	assert.Len(t, km.cells, 0)
+	assert.Equal(t, 0.0, km.ExploredPercentage())
+
+	assert.Nil(t, NewKnowledgeMap(nil))
+}
+
+func TestKnowledgeMap_Reveal(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		centre  Coordinate
+		radius  int
+		asserts func(km *KnowledgeMap)
+	}{
+		{
+			name:   "Radius 0 reveals only the centre",
+			centre: Coordinate{1, 1},
+			radius: 0,
+			asserts: func(km *KnowledgeMap) {
+				assert.Len(t, km.cells, 1)
+				assert.Equal(t, Free, km.Cell(1, 1))
+				assert.Equal(t, Unknown, km.Cell(1, 2))
+			},
+		},
+		{
+			name:   "Radius 1 reveals a cross",
+			centre: Coordinate{1, 1},
+			radius: 1,
+			asserts: func(km *KnowledgeMap) {
+				assert.Len(t, km.cells, 5)
+				assert.Equal(t, Free, km.Cell(1, 2))
+				assert.Equal(t, Free, km.Cell(0, 1))
+				assert.Equal(t, Unknown, km.Cell(0, 0))
+			},
+		},
+		{
+			name:   "Cells out of the map are blocked",
+			centre: Coordinate{0, 0},
+			radius: 1,
+			asserts: func(km *KnowledgeMap) {
+				assert.Equal(t, Blocked, km.Cell(-1, 0))
+				assert.Equal(t, Blocked, km.Cell(0, -1))
+				assert.False(t, km.IsValid(-1, 0))
+				assert.True(t, km.IsValid(0, 1))
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			km := NewKnowledgeMap(NewMap(4, 4))
+
+			// when
+			km.Reveal(tt.centre, tt.radius)
+
+			//then
+			tt.asserts(km)
+		})
+	}
+}
+
+func TestKnowledgeMap_ExploredPercentage(t *testing.T) {
+	//Given
+	km := NewKnowledgeMap(NewMap(4, 4))
+
+	//When
+	km.Reveal(Coordinate{0, 0}, 1)
+
+	//Then
+	assert.Equal(t, 18.75, km.ExploredPercentage())
+
+	//When
+	km.Reveal(Coordinate{2, 2}, 3)
+
+	//Then
+	assert.Equal(t, 100.0, km.ExploredPercentage())
+
+	//Given
+	km = NewKnowledgeMap(NewUnboundedSparseMap())
+
+	//When
+	km.Reveal(Coordinate{0, 0}, 1)
+
+	//Then
+	assert.Equal(t, 0.0, km.ExploredPercentage())
+
+	//Given
+	lShaped := NewPolygonMap(Coordinate{0, 0}, Coordinate{4, 0}, Coordinate{4, 2}, Coordinate{2, 2}, Coordinate{2, 4}, Coordinate{0, 4})
+	km = NewKnowledgeMap(lShaped)
+
+	//When
+	km.Reveal(Coordinate{0, 0}, 1)
+
+	//Then
+	assert.Equal(t, 25.0, km.ExploredPercentage(), "Only the 12 cells inside the polygon must be counted")
+
+	//When
+	km.Reveal(Coordinate{0, 0}, 8)
+
+	//Then
+	assert.Equal(t, 100.0, km.ExploredPercentage())
+
+	//Given
+	withHole := NewRectangleSetMap(Rectangle{0, 0, 4, 4}).Difference(Rectangle{1, 1, 2, 2})
+	km = NewKnowledgeMap(withHole)
+
+	//When
+	km.Reveal(Coordinate{0, 0}, 8)
+
+	//Then
+	assert.Equal(t, 100.0, km.ExploredPercentage(), "The cells of the hole must not be counted")
+}
+
+func TestKnowledgeMap_Render(t *testing.T) {
+	//Given
+	truth := NewSparseMap(4, 3)
+	truth.SetObstacle(1, 1)
+	km := NewKnowledgeMap(truth)
+
+	//When
+	km.Reveal(Coordinate{0, 1}, 1)
+
+	//Then
+	assert.Equal(t, ".???\n.#??\n.???\n", km.Render())
+
+	//Given
+	km = NewKnowledgeMap(NewUnboundedSparseMap())
+
+	//When
+	km.Reveal(Coordinate{-5, -5}, 1)
+
+	//Then
+	assert.Equal(t, "?.?\n...\n?.?\n", km.Render())
+}
+
+func TestEquipSensor(t *testing.T) {
+	//Given
+	truth := NewSparseMap(5, 5)
+	truth.SetObstacle(2, 3)
+	rover := NewRover(truth)
+
+	//When
+	knowledge := rover.EquipSensor(1)
+	output, err := rover.Travel(0, 0, North, "AARAA")
+
+	//Then
+	assert.Nil(t, err)
+	assert.Equal(t, "True, E, (2,2)", output)
+	assert.Equal(t, "?????\n..#??\n....?\n...??\n..???\n", knowledge.Render())
+	assert.InDelta(t, 100.0*11/24, knowledge.ExploredPercentage(), 1e-9, "The obstacle is not one of the 24 valid cells")
+	assert.True(t, truth.IsValid(3, 3), "The ground truth map must not change")
+
+	//Given
+	rover = nil
+
+	//Then
+	assert.Nil(t, rover.EquipSensor(1))
+}
@@ -0,0 +1,82 @@
+package rover
+
+import (
+	"fmt"
+)
+
+// Kinds of PlanetaryMap a MapSpec can describe.
+const (
+	RectangleMapKind    = "rectangle"
+	PolygonMapKind      = "polygon"
+	RectangleSetMapKind = "rectangles"
+	SparseMapKind       = "sparse"
+)
+
+// RectangleSpec is one of the operations of a rectangle set map. Rectangles are added to the map unless Exclude
+// is set, in which case they are removed from it.
+type RectangleSpec struct {
+	Rectangle
+	Exclude bool `json:"exclude,omitempty"`
+}
+
+// MapSpec is a serializable description of a PlanetaryMap, used to load maps from files and to record them.
+//
+//	-rectangle uses Width and Height.
+//	-polygon uses Vertices.
+//	-rectangles uses Rectangles.
+//	-sparse uses Width, Height and Obstacles, or Unbounded and Obstacles.
+type MapSpec struct {
+	Kind       string          `json:"kind"`
+	Width      int             `json:"width,omitempty"`
+	Height     int             `json:"height,omitempty"`
+	Unbounded  bool            `json:"unbounded,omitempty"`
+	Vertices   []Coordinate    `json:"vertices,omitempty"`
+	Rectangles []RectangleSpec `json:"rectangles,omitempty"`
+	Obstacles  []Coordinate    `json:"obstacles,omitempty"`
+}
+
+// Build creates the PlanetaryMap described by the spec.
+func (s MapSpec) Build() (PlanetaryMap, error) {
+
+	switch s.Kind {
+	case RectangleMapKind:
+		if s.Width <= 0 || s.Height <= 0 {
+			return nil, fmt.Errorf("%w: %vx%v is not a valid map size", ErrInvalidMapSpec, s.Width, s.Height)
+		}
+		return NewMap(s.Width, s.Height), nil
+
+	case PolygonMapKind:
+		if len(s.Vertices) < 3 {
+			return nil, fmt.Errorf("%w: a polygon needs at least 3 vertices and got %v", ErrInvalidMapSpec, len(s.Vertices))
+		}
+		return NewPolygonMap(s.Vertices...), nil
+
+	case RectangleSetMapKind:
+		m := NewRectangleSetMap()
+		for _, r := range s.Rectangles {
+			if r.Exclude {
+				m.Difference(r.Rectangle)
+			} else {
+				m.Union(r.Rectangle)
+			}
+		}
+		return m, nil
+
+	case SparseMapKind:
+		var m *SparseMap
+		if s.Unbounded {
+			m = NewUnboundedSparseMap()
+		} else {
+			if s.Width <= 0 || s.Height <= 0 {
+				return nil, fmt.Errorf("%w: %vx%v is not a valid map size", ErrInvalidMapSpec, s.Width, s.Height)
+			}
+			m = NewSparseMap(s.Width, s.Height)
+		}
+		for _, o := range s.Obstacles {
+			m.SetObstacle(o.X, o.Y)
+		}
+		return m, nil
+	}
+
+	return nil, fmt.Errorf("%w: %v is not a valid map kind", ErrInvalidMapSpec, s.Kind)
+}
@@ -0,0 +1,104 @@
+package rover
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMapSpec_Build(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		spec    string
+		asserts func(pm PlanetaryMap, err error)
+	}{
+		{
+			name: "Rectangle",
+			spec: `{"kind":"rectangle","width":4,"height":3}`,
+			asserts: func(pm PlanetaryMap, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, NewMap(4, 3), pm)
+			},
+		},
+		{
+			name: "Rectangle without size",
+			spec: `{"kind":"rectangle","width":4}`,
+			asserts: func(pm PlanetaryMap, err error) {
+				assert.NotNil(t, err)
+				assert.Nil(t, pm)
+			},
+		},
+		{
+			name: "Polygon",
+			spec: `{"kind":"polygon","vertices":[{"x":0,"y":0},{"x":4,"y":0},{"x":0,"y":4}]}`,
+			asserts: func(pm PlanetaryMap, err error) {
+				assert.Nil(t, err)
+				assert.True(t, pm.IsValid(1, 1))
+				assert.False(t, pm.IsValid(2, 2))
+			},
+		},
+		{
+			name: "Polygon without enough vertices",
+			spec: `{"kind":"polygon","vertices":[{"x":0,"y":0},{"x":4,"y":0}]}`,
+			asserts: func(pm PlanetaryMap, err error) {
+				assert.NotNil(t, err)
+			},
+		},
+		{
+			name: "Rectangles",
+			spec: `{"kind":"rectangles","rectangles":[{"x":0,"y":0,"width":3,"height":3},{"x":1,"y":1,"width":1,"height":1,"exclude":true}]}`,
+			asserts: func(pm PlanetaryMap, err error) {
+				assert.Nil(t, err)
+				assert.True(t, pm.IsValid(0, 0))
+				assert.False(t, pm.IsValid(1, 1))
+			},
+		},
+		{
+			name: "Sparse",
+			spec: `{"kind":"sparse","width":10,"height":10,"obstacles":[{"x":2,"y":3}]}`,
+			asserts: func(pm PlanetaryMap, err error) {
+				assert.Nil(t, err)
+				assert.False(t, pm.IsValid(2, 3))
+				assert.False(t, pm.IsValid(10, 3))
+			},
+		},
+		{
+			name: "Unbounded sparse",
+			spec: `{"kind":"sparse","unbounded":true,"obstacles":[{"x":-2,"y":-3}]}`,
+			asserts: func(pm PlanetaryMap, err error) {
+				assert.Nil(t, err)
+				assert.False(t, pm.IsValid(-2, -3))
+				assert.True(t, pm.IsValid(-2000, 3000))
+			},
+		},
+		{
+			name: "Sparse without size",
+			spec: `{"kind":"sparse"}`,
+			asserts: func(pm PlanetaryMap, err error) {
+				assert.NotNil(t, err)
+			},
+		},
+		{
+			name: "Unknown kind",
+			spec: `{"kind":"hexagonal"}`,
+			asserts: func(pm PlanetaryMap, err error) {
+				assert.NotNil(t, err)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			spec := MapSpec{}
+			assert.Nil(t, json.Unmarshal([]byte(tt.spec), &spec))
+
+			// when
+			pm, err := spec.Build()
+
+			//then
+			tt.asserts(pm, err)
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package rover
+
+import (
+	"errors"
+	"fmt"
+)
+
+// missionSearchMargin is how far around the start and the waypoints paths are searched on unbounded maps.
+const missionSearchMargin = 32
+
+// ErrUnreachableWaypoint is returned when no path leads to a waypoint.
+var ErrUnreachableWaypoint = errors.New("waypoint can not be reached")
+
+// Waypoint is a cell a Mission goes through. When Orientation is set the Rover turns to it once there.
+type Waypoint struct {
+	Name        string        `json:"name,omitempty"`
+	X           int           `json:"x"`
+	Y           int           `json:"y"`
+	Orientation CardinalPoint `json:"orientation,omitempty"`
+}
+
+func (w Waypoint) String() string {
+
+	description := fmt.Sprintf("(%v,%v)", w.X, w.Y)
+	if w.Orientation != "" {
+		description += " facing " + string(w.Orientation)
+	}
+
+	if w.Name != "" {
+		description = w.Name + " " + description
+	}
+
+	return description
+}
+
+// Mission is an ordered list of waypoints. With ReturnToBase it ends with a leg back to the start cell.
+type Mission struct {
+	Waypoints    []Waypoint `json:"waypoints"`
+	ReturnToBase bool       `json:"returnToBase,omitempty"`
+}
+
+// Leg is the way from a pose to the next Waypoint of a Mission. Number is 1-based.
+type Leg struct {
+	Number   int
+	Waypoint Waypoint
+	From     Pose
+	To       Pose
+	Commands string
+}
+
+// LegResult is the outcome of executing a Leg, formatted like Travel does in Output.
+type LegResult struct {
+	Leg
+	Output string
+	Result TravelResult
+}
+
+// MissionReport tells how far a Mission went. Failure names the first leg that could not be completed.
+type MissionReport struct {
+	Legs      []LegResult
+	Completed bool
+	Failure   *LegError
+}
+
+// LegError tells why a Leg of a Mission could not be planned or completed.
+type LegError struct {
+	Leg      int
+	Waypoint Waypoint
+	Err      error
+}
+
+func (e *LegError) Error() string {
+	return fmt.Sprintf("leg %v to %v: %v", e.Leg, e.Waypoint, e.Err)
+}
+
+// Unwrap returns the reason of the failure.
+func (e *LegError) Unwrap() error {
+	return e.Err
+}
+
+// waypoints returns the waypoints of the Mission, including the base when it returns to it.
+func (mission Mission) waypoints(start Pose) []Waypoint {
+
+	waypoints := append([]Waypoint(nil), mission.Waypoints...)
+	if mission.ReturnToBase {
+		waypoints = append(waypoints, Waypoint{Name: "base", X: start.X, Y: start.Y})
+	}
+
+	return waypoints
+}
+
+// searchArea returns the cells paths can go through: the map's bounds, or an area around the start and the
+// waypoints when the map has none.
+func (mission Mission) searchArea(m PlanetaryMap, start Pose) func(Coordinate) bool {
+
+	bounds, ok := MapBounds(m)
+	if !ok {
+		left, right, bottom, top := start.X, start.X, start.Y, start.Y
+		for _, w := range mission.Waypoints {
+			left, right = minInt(left, w.X), maxInt(right, w.X)
+			bottom, top = minInt(bottom, w.Y), maxInt(top, w.Y)
+		}
+		bounds = Rectangle{
+			X:      left - missionSearchMargin,
+			Y:      bottom - missionSearchMargin,
+			Width:  right - left + 2*missionSearchMargin + 1,
+			Height: top - bottom + 2*missionSearchMargin + 1,
+		}
+	}
+
+	return func(c Coordinate) bool { return bounds.Contains(c.X, c.Y) }
+}
+
+// planLeg finds the shortest list of commands from a pose to a waypoint, counting turns as well as advances.
+func planLeg(m PlanetaryMap, from Pose, number int, waypoint Waypoint, inSearch func(Coordinate) bool) (Leg, *LegError) {
+
+	if !m.IsValid(waypoint.X, waypoint.Y) {
+		return Leg{}, &LegError{Leg: number, Waypoint: waypoint, Err: &InvalidCoordinateError{X: waypoint.X, Y: waypoint.Y}}
+	}
+
+	if waypoint.Orientation != "" && !waypoint.Orientation.IsValid() {
+		return Leg{}, &LegError{Leg: number, Waypoint: waypoint, Err: &InvalidOrientationError{Orientation: waypoint.Orientation}}
+	}
+
+	commands, to, ok := shortestCommands(m, from, func(p Pose) bool {
+		return p.X == waypoint.X && p.Y == waypoint.Y && (waypoint.Orientation == "" || p.Orientation == waypoint.Orientation)
+	}, inSearch)
+	if !ok {
+		return Leg{}, &LegError{Leg: number, Waypoint: waypoint, Err: ErrUnreachableWaypoint}
+	}
+
+	return Leg{Number: number, Waypoint: waypoint, From: from, To: to, Commands: commands}, nil
+}
+
+// PlanMission stitches together the shortest list of commands for every leg of the Mission on the map,
+// assuming each leg ends where it was planned to. Commands are written with the English alphabet.
+func PlanMission(m PlanetaryMap, start Pose, mission Mission) ([]Leg, error) {
+
+	if m == nil {
+		return nil, ErrMapNotInitialized
+	}
+
+	if err := validateStart(m, start); err != nil {
+		return nil, err
+	}
+
+	inSearch := mission.searchArea(m, start)
+	legs := []Leg{}
+	from := start
+
+	for i, waypoint := range mission.waypoints(start) {
+		leg, err := planLeg(m, from, i+1, waypoint, inSearch)
+		if err != nil {
+			return legs, err
+		}
+		legs = append(legs, leg)
+		from = leg.To
+	}
+
+	return legs, nil
+}
+
+// RunMission executes the Mission leg by leg like Travel does, planning every leg from the pose the previous one
+// actually ended at and around the Rover's geofences. It stops at the first leg that can not be planned or that
+// ends with a rejected advance.
+func (r *Rover) RunMission(start Pose, mission Mission) (MissionReport, error) {
+
+	if err := r.ValidatePose(start); err != nil {
+		return MissionReport{}, err
+	}
+
+	report := MissionReport{Legs: []LegResult{}}
+	inSearch := mission.searchArea(r.navigationMap, start)
+	from := start
+
+	for i, waypoint := range mission.waypoints(start) {
+		leg, legErr := planLeg(planetaryMapFunc(r.canEnter), from, i+1, waypoint, inSearch)
+		if legErr != nil {
+			report.Failure = legErr
+			return report, nil
+		}
+
+		result := r.run(from, canonicalCommands(leg.Commands))
+		output, err := r.output.Format(result)
+		if err != nil {
+			return report, err
+		}
+		report.Legs = append(report.Legs, LegResult{Leg: leg, Output: output, Result: result})
+
+		if !result.Valid {
+			blocked := result.Pose.next(Advance)
+			var blockedErr error = &OutOfBoundsError{X: blocked.X, Y: blocked.Y}
+			if result.Geofence != "" {
+				blockedErr = &GeofenceError{Geofence: result.Geofence, X: blocked.X, Y: blocked.Y}
+			}
+			report.Failure = &LegError{Leg: leg.Number, Waypoint: waypoint, Err: blockedErr}
+			return report, nil
+		}
+		from = result.Pose
+	}
+
+	report.Completed = true
+	return report, nil
+}
+
+// canonicalCommands converts commands written with the English alphabet by a planner, which are always valid.
+func canonicalCommands(listOfCommands string) []Command {
+
+	commands := make([]Command, 0, len(listOfCommands))
+	for _, c := range listOfCommands {
+		commands = append(commands, Command(string(c)))
+	}
+
+	return commands
+}
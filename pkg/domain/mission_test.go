@@ -0,0 +1,257 @@
+package rover
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanMission(t *testing.T) {
+
+	// Wall from (1,1) to (1,3):
+	//
+	//	3 .#..
+	//	2 .#..
+	//	1 .#..
+	//	0 ....
+	//	  0123
+	walled := NewSparseMap(4, 4)
+	walled.SetObstacle(1, 1)
+	walled.SetObstacle(1, 2)
+	walled.SetObstacle(1, 3)
+
+	testCases := []struct {
+		name    string
+		m       PlanetaryMap
+		start   Pose
+		mission Mission
+		asserts func(legs []Leg, err error)
+	}{
+		{
+			name:    "Waypoints with orientation and return to base",
+			m:       NewMap(6, 6),
+			start:   Pose{0, 0, North},
+			mission: Mission{Waypoints: []Waypoint{{X: 2, Y: 3}, {X: 5, Y: 5, Orientation: North}}, ReturnToBase: true},
+			asserts: func(legs []Leg, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, []Leg{
+					{Number: 1, Waypoint: Waypoint{X: 2, Y: 3}, From: Pose{0, 0, North}, To: Pose{2, 3, East}, Commands: "AAARAA"},
+					{Number: 2, Waypoint: Waypoint{X: 5, Y: 5, Orientation: North}, From: Pose{2, 3, East}, To: Pose{5, 5, North}, Commands: "AAALAA"},
+					{Number: 3, Waypoint: Waypoint{Name: "base"}, From: Pose{5, 5, North}, To: Pose{0, 0, South}, Commands: "LAAAAALAAAAA"},
+				}, legs)
+			},
+		},
+		{
+			name:    "Around a wall",
+			m:       walled,
+			start:   Pose{0, 3, South},
+			mission: Mission{Waypoints: []Waypoint{{Name: "rock", X: 2, Y: 3, Orientation: West}}},
+			asserts: func(legs []Leg, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, "AAALAALAAAL", legs[0].Commands)
+				assert.Equal(t, Pose{2, 3, West}, legs[0].To)
+			},
+		},
+		{
+			name:    "Unbounded map",
+			m:       NewUnboundedSparseMap(),
+			start:   Pose{0, 0, North},
+			mission: Mission{Waypoints: []Waypoint{{X: -2, Y: 1}}},
+			asserts: func(legs []Leg, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, "ALAA", legs[0].Commands)
+			},
+		},
+		{
+			name:    "Unreachable waypoint",
+			m:       NewRectangleSetMap(Rectangle{0, 0, 2, 2}, Rectangle{3, 0, 2, 2}),
+			start:   Pose{0, 0, North},
+			mission: Mission{Waypoints: []Waypoint{{X: 1, Y: 1}, {Name: "crater", X: 4, Y: 1}}},
+			asserts: func(legs []Leg, err error) {
+				assert.Len(t, legs, 1)
+				assert.ErrorIs(t, err, ErrUnreachableWaypoint)
+				assert.EqualError(t, err, "leg 2 to crater (4,1): waypoint can not be reached")
+			},
+		},
+		{
+			name:    "Waypoint outside the map",
+			m:       NewMap(2, 2),
+			start:   Pose{0, 0, North},
+			mission: Mission{Waypoints: []Waypoint{{X: 2, Y: 2, Orientation: East}}},
+			asserts: func(legs []Leg, err error) {
+				assert.Empty(t, legs)
+				assert.ErrorIs(t, err, ErrInvalidCoordinate)
+				assert.EqualError(t, err, "leg 1 to (2,2) facing E: (2,2) are not valid x and y coordinates")
+			},
+		},
+		{
+			name:    "Invalid waypoint orientation",
+			m:       NewMap(2, 2),
+			start:   Pose{0, 0, North},
+			mission: Mission{Waypoints: []Waypoint{{X: 1, Y: 1, Orientation: "X"}}},
+			asserts: func(legs []Leg, err error) {
+				assert.ErrorIs(t, err, ErrInvalidOrientation)
+			},
+		},
+		{
+			name:    "Invalid start",
+			m:       NewMap(2, 2),
+			start:   Pose{0, 2, North},
+			mission: Mission{},
+			asserts: func(legs []Leg, err error) {
+				assert.Nil(t, legs)
+				assert.ErrorIs(t, err, ErrInvalidCoordinate)
+			},
+		},
+		{
+			name:    "Nil map",
+			start:   Pose{0, 0, North},
+			mission: Mission{},
+			asserts: func(legs []Leg, err error) {
+				assert.ErrorIs(t, err, ErrMapNotInitialized)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			// when
+			legs, err := PlanMission(tc.m, tc.start, tc.mission)
+
+			//then
+			tc.asserts(legs, err)
+		})
+	}
+}
+
+func TestRunMission(t *testing.T) {
+
+	mission := Mission{Waypoints: []Waypoint{{X: 0, Y: 2}, {X: 2, Y: 2, Orientation: South}}, ReturnToBase: true}
+
+	testCases := []struct {
+		name    string
+		rover   func() *Rover
+		asserts func(report MissionReport, err error)
+	}{
+		{
+			name:  "Completed mission",
+			rover: func() *Rover { return NewRover(NewMap(3, 3)) },
+			asserts: func(report MissionReport, err error) {
+				assert.Nil(t, err)
+				assert.True(t, report.Completed)
+				assert.Nil(t, report.Failure)
+				assert.Len(t, report.Legs, 3)
+				assert.Equal(t, "True, N, (0,2)", report.Legs[0].Output)
+				assert.Equal(t, "True, S, (2,2)", report.Legs[1].Output)
+				assert.Equal(t, LegResult{
+					Leg:    Leg{Number: 3, Waypoint: Waypoint{Name: "base"}, From: Pose{2, 2, South}, To: Pose{0, 0, West}, Commands: "AARAA"},
+					Output: "True, W, (0,0)",
+					Result: TravelResult{Valid: true, Pose: Pose{0, 0, West}},
+				}, report.Legs[2])
+			},
+		},
+		{
+			name: "Spanish rover",
+			rover: func() *Rover {
+				r := NewRover(NewMap(3, 3))
+				r.UseAlphabet(SpanishAlphabet)
+				return r
+			},
+			asserts: func(report MissionReport, err error) {
+				assert.Nil(t, err)
+				assert.True(t, report.Completed)
+				assert.Equal(t, "Verdadero, O, (0,0)", report.Legs[2].Output)
+			},
+		},
+		{
+			name: "Obstacle appears during the second leg",
+			rover: func() *Rover {
+				m := NewSparseMap(3, 3)
+				r := NewRover(m)
+				r.OnStep(func(event StepEvent) {
+					if event.Pose == (Pose{0, 2, East}) {
+						m.SetObstacle(2, 2)
+					}
+				})
+				return r
+			},
+			asserts: func(report MissionReport, err error) {
+				assert.Nil(t, err)
+				assert.False(t, report.Completed)
+				assert.Len(t, report.Legs, 2)
+				assert.Equal(t, Pose{1, 2, East}, report.Legs[1].Result.Pose)
+				assert.ErrorIs(t, report.Failure, ErrOutOfBounds)
+				assert.EqualError(t, report.Failure, "leg 2 to (2,2) facing S: can not advance to (2,2)")
+			},
+		},
+		{
+			name: "Geofence added during the second leg",
+			rover: func() *Rover {
+				r := NewRover(NewMap(3, 3))
+				r.OnStep(func(event StepEvent) {
+					if event.Pose == (Pose{1, 2, East}) {
+						r.AddGeofences(NoGo("lander", NewRectangleSetMap(Rectangle{2, 2, 1, 1})))
+					}
+				})
+				return r
+			},
+			asserts: func(report MissionReport, err error) {
+				assert.Nil(t, err)
+				assert.False(t, report.Completed)
+				assert.Len(t, report.Legs, 2)
+				assert.Equal(t, "lander", report.Legs[1].Result.Geofence)
+				assert.ErrorIs(t, report.Failure, ErrGeofenceViolation)
+				assert.EqualError(t, report.Failure, "leg 2 to (2,2) facing S: geofence lander forbids advancing to (2,2)")
+			},
+		},
+		{
+			name: "Waypoint walled off before its leg",
+			rover: func() *Rover {
+				m := NewSparseMap(3, 3)
+				r := NewRover(m)
+				r.OnStep(func(event StepEvent) {
+					if event.Pose == (Pose{0, 2, North}) {
+						m.SetObstacle(1, 2)
+						m.SetObstacle(2, 1)
+					}
+				})
+				return r
+			},
+			asserts: func(report MissionReport, err error) {
+				assert.Nil(t, err)
+				assert.False(t, report.Completed)
+				assert.Len(t, report.Legs, 1)
+				assert.Equal(t, 2, report.Failure.Leg)
+				assert.ErrorIs(t, report.Failure, ErrUnreachableWaypoint)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			// given
+			r := tc.rover()
+
+			// when
+			report, err := r.RunMission(Pose{0, 0, North}, mission)
+
+			//then
+			tc.asserts(report, err)
+		})
+	}
+}
+
+func TestRunMission_Errors(t *testing.T) {
+
+	var nilRover *Rover
+	_, err := nilRover.RunMission(Pose{0, 0, North}, Mission{})
+	assert.ErrorIs(t, err, ErrRoverNotInitialized)
+
+	_, err = NewRover(NewMap(2, 2)).RunMission(Pose{0, 0, "X"}, Mission{})
+	assert.ErrorIs(t, err, ErrInvalidOrientation)
+
+	_, err = NewRover(NewMap(2, 2)).RunMission(Pose{2, 0, North}, Mission{})
+	assert.ErrorIs(t, err, ErrInvalidCoordinate)
+}
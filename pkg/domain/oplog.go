@@ -0,0 +1,274 @@
+package rover
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Kinds of Operation written to an operation log.
+const (
+	MapOperation    = "map"
+	TravelOperation = "travel"
+	StepOperation   = "step"
+	ResultOperation = "result"
+	ErrorOperation  = "error"
+)
+
+// Operation is a line of an operation log. Op tells which of the other fields are set.
+//
+//	-map has Map, Autonomy and Geofences, and is always the first line.
+//	-travel has Start and Commands, with the commands written with the English alphabet.
+//	-step has Step, one line for every step of the travel.
+//	-result has Result, the outcome of the travel.
+//	-error has Commands and Error, for a travel that was rejected before starting.
+type Operation struct {
+	Op        string         `json:"op"`
+	Map       *MapSpec       `json:"map,omitempty"`
+	Autonomy  int            `json:"autonomy,omitempty"`
+	Geofences []GeofenceSpec `json:"geofences,omitempty"`
+	Start     *Pose          `json:"start,omitempty"`
+	Commands  string         `json:"commands,omitempty"`
+	Step      *StepEvent     `json:"step,omitempty"`
+	Result    *TravelResult  `json:"result,omitempty"`
+	Error     string         `json:"error,omitempty"`
+}
+
+// Recorder drives a Rover and appends everything it does to an operation log written as JSON Lines.
+// The log can be given to Replay to check that a fresh Rover does exactly the same.
+type Recorder struct {
+	rover   *Rover
+	encoder *json.Encoder
+	err     error
+}
+
+// NewRecorder creates a Rover on the map described by the spec, with the geofences attached, and starts its log
+// with the map configuration. The Rover is only driven through the Recorder, so that every travel it makes ends
+// up in the log.
+func NewRecorder(w io.Writer, spec MapSpec, autonomyBudget int, geofences ...GeofenceSpec) (*Recorder, error) {
+
+	navigationMap, err := spec.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	built, err := buildGeofences(geofences)
+	if err != nil {
+		return nil, err
+	}
+
+	newRecorder := Recorder{
+		rover:   NewRover(navigationMap),
+		encoder: json.NewEncoder(w),
+	}
+	newRecorder.rover.EnableAutonomy(autonomyBudget)
+	newRecorder.rover.AddGeofences(built...)
+	newRecorder.rover.OnStep(func(event StepEvent) {
+		newRecorder.write(Operation{Op: StepOperation, Step: &event})
+	})
+
+	newRecorder.write(Operation{Op: MapOperation, Map: &spec, Autonomy: autonomyBudget, Geofences: geofences})
+	if newRecorder.err != nil {
+		return nil, newRecorder.err
+	}
+
+	return &newRecorder, nil
+}
+
+// UseAlphabet sets the characters the recorded Rover accepts for commands. It does not have to be the same on
+// replay, as commands are logged with the English alphabet.
+func (rec *Recorder) UseAlphabet(alphabet Alphabet) {
+	rec.rover.UseAlphabet(alphabet)
+}
+
+// Travel works like Rover.Execute and logs the travel, its steps and its result.
+func (rec *Recorder) Travel(initialX int, initialY int, initialOrientation CardinalPoint, listOfCommands string) (TravelResult, error) {
+
+	start, commands, err := rec.rover.prepare(initialX, initialY, initialOrientation, listOfCommands)
+	if err != nil {
+		rec.write(Operation{Op: ErrorOperation, Commands: listOfCommands, Error: err.Error()})
+		return TravelResult{}, errors.Join(err, rec.err)
+	}
+
+	var canonical strings.Builder
+	for _, command := range commands {
+		canonical.WriteString(string(command))
+	}
+
+	rec.write(Operation{Op: TravelOperation, Start: &start, Commands: canonical.String()})
+
+	result := rec.rover.run(start, commands)
+	rec.write(Operation{Op: ResultOperation, Result: &result})
+
+	return result, rec.err
+}
+
+// buildGeofences builds the geofences of a log.
+func buildGeofences(specs []GeofenceSpec) ([]Geofence, error) {
+
+	geofences := make([]Geofence, 0, len(specs))
+	for _, spec := range specs {
+		g, err := spec.Build()
+		if err != nil {
+			return nil, fmt.Errorf("geofence %v: %w", spec.Name, err)
+		}
+		geofences = append(geofences, g)
+	}
+
+	return geofences, nil
+}
+
+// write appends the operation to the log, remembering the first error so that it is not lost.
+func (rec *Recorder) write(operation Operation) {
+	if rec.err == nil {
+		rec.err = rec.encoder.Encode(operation)
+	}
+}
+
+// ErrReplayDiverged is matched by DivergenceError.
+var ErrReplayDiverged = errors.New("replay diverged from the log")
+
+// DivergenceError reports the first line of an operation log that a replay could not reproduce.
+// Expected is the line in the log and Actual what the replay did instead, both as JSON. An empty Expected
+// means the log ended too soon and an empty Actual that the replay did less than the log.
+type DivergenceError struct {
+	Line     int
+	Expected string
+	Actual   string
+}
+
+func (e *DivergenceError) Error() string {
+	return fmt.Sprintf("replay diverged at line %v: expected %v but got %v", e.Line, orNothing(e.Expected), orNothing(e.Actual))
+}
+
+// Is makes the error match ErrReplayDiverged.
+func (e *DivergenceError) Is(target error) bool {
+	return target == ErrReplayDiverged
+}
+
+func orNothing(value string) string {
+	if value == "" {
+		return "nothing"
+	}
+	return value
+}
+
+// ReplayReport summarizes a successful replay.
+type ReplayReport struct {
+	Operations int
+	Travels    int
+}
+
+// Replay re-executes an operation log against a fresh Rover and verifies that every step and result matches.
+// It stops at the first divergence, returning a DivergenceError.
+func Replay(log io.Reader) (ReplayReport, error) {
+
+	report := ReplayReport{}
+	scanner := bufio.NewScanner(log)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	var rover *Rover
+	var pending []Operation
+	line := 0
+
+	for scanner.Scan() {
+		line++
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+
+		operation := Operation{}
+		if err := json.Unmarshal(scanner.Bytes(), &operation); err != nil {
+			return report, fmt.Errorf("line %v is not a valid operation: %w", line, err)
+		}
+		report.Operations++
+
+		if rover == nil && operation.Op != MapOperation {
+			return report, fmt.Errorf("line %v: the log must start with a map operation", line)
+		}
+
+		switch operation.Op {
+		case MapOperation:
+			if rover != nil {
+				return report, fmt.Errorf("line %v: the log can only have one map operation", line)
+			}
+			if operation.Map == nil {
+				return report, fmt.Errorf("line %v: map operation without a map", line)
+			}
+			navigationMap, err := operation.Map.Build()
+			if err != nil {
+				return report, fmt.Errorf("line %v: %w", line, err)
+			}
+			geofences, err := buildGeofences(operation.Geofences)
+			if err != nil {
+				return report, fmt.Errorf("line %v: %w", line, err)
+			}
+			rover = NewRover(navigationMap)
+			rover.EnableAutonomy(operation.Autonomy)
+			rover.AddGeofences(geofences...)
+			rover.OnStep(func(event StepEvent) {
+				pending = append(pending, Operation{Op: StepOperation, Step: &event})
+			})
+
+		case TravelOperation:
+			if len(pending) > 0 {
+				return report, divergence(line, nil, &pending[0])
+			}
+			if operation.Start == nil {
+				return report, fmt.Errorf("line %v: travel operation without a start", line)
+			}
+			result, err := rover.Execute(operation.Start.X, operation.Start.Y, operation.Start.Orientation, operation.Commands)
+			if err != nil {
+				return report, &DivergenceError{Line: line, Expected: scanner.Text(), Actual: err.Error()}
+			}
+			pending = append(pending, Operation{Op: ResultOperation, Result: &result})
+			report.Travels++
+
+		case StepOperation, ResultOperation:
+			if len(pending) == 0 {
+				return report, divergence(line, &operation, nil)
+			}
+			if !sameOperation(operation, pending[0]) {
+				return report, divergence(line, &operation, &pending[0])
+			}
+			pending = pending[1:]
+
+		case ErrorOperation:
+			// Rejected travels did not change the Rover, so there is nothing to reproduce.
+
+		default:
+			return report, fmt.Errorf("line %v: %v is not a valid operation", line, operation.Op)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return report, err
+	}
+
+	if len(pending) > 0 {
+		return report, divergence(line+1, nil, &pending[0])
+	}
+
+	return report, nil
+}
+
+func sameOperation(a, b Operation) bool {
+	return marshalOperation(&a) == marshalOperation(&b)
+}
+
+func divergence(line int, expected, actual *Operation) *DivergenceError {
+	return &DivergenceError{Line: line, Expected: marshalOperation(expected), Actual: marshalOperation(actual)}
+}
+
+func marshalOperation(operation *Operation) string {
+
+	if operation == nil {
+		return ""
+	}
+
+	content, _ := json.Marshal(operation)
+	return string(content)
+}
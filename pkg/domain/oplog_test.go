@@ -0,0 +1,200 @@
+package rover
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func recordTravels(t *testing.T, autonomy int) string {
+
+	spec := MapSpec{Kind: SparseMapKind, Width: 5, Height: 5, Obstacles: []Coordinate{{2, 0}}}
+	log := bytes.Buffer{}
+
+	recorder, err := NewRecorder(&log, spec, autonomy)
+	assert.Nil(t, err)
+
+	recorder.UseAlphabet(SpanishAlphabet)
+	_, err = recorder.Travel(0, 0, "E", "AAAA")
+	assert.Nil(t, err)
+	_, err = recorder.Travel(0, 0, "N", "XA")
+	assert.NotNil(t, err)
+	_, err = recorder.Travel(1, 1, West, "IDA")
+	assert.Nil(t, err)
+
+	return log.String()
+}
+
+func TestRecorder(t *testing.T) {
+
+	// given
+	// when
+	log := recordTravels(t, 4)
+
+	//then
+	lines := strings.Split(strings.TrimSpace(log), "\n")
+	assert.Equal(t, `{"op":"map","map":{"kind":"sparse","width":5,"height":5,"obstacles":[{"x":2,"y":0}]},"autonomy":4}`, lines[0])
+	assert.Equal(t, `{"op":"travel","start":{"x":0,"y":0,"orientation":"E"},"commands":"AAAA"}`, lines[1])
+	assert.Equal(t, `{"op":"step","step":{"step":0,"pose":{"x":0,"y":0,"orientation":"E"},"accepted":true}}`, lines[2])
+	assert.Contains(t, log, `"detour":true`)
+	assert.Contains(t, log, `{"op":"error","commands":"XA","error":"X at position 1 is not a valid command"}`)
+	assert.Contains(t, log, `{"op":"travel","start":{"x":1,"y":1,"orientation":"W"},"commands":"LRA"}`)
+	assert.Equal(t, `{"op":"result","result":{"valid":true,"pose":{"x":0,"y":1,"orientation":"W"}}}`, lines[len(lines)-1])
+}
+
+func TestRecorder_InvalidSpec(t *testing.T) {
+
+	// given
+	log := bytes.Buffer{}
+
+	// when
+	recorder, err := NewRecorder(&log, MapSpec{Kind: "hexagon"}, 0)
+
+	//then
+	assert.Nil(t, recorder)
+	assert.True(t, errors.Is(err, ErrInvalidMapSpec))
+	assert.Empty(t, log.String())
+}
+
+func TestRecorder_RejectedTravels(t *testing.T) {
+
+	// given
+	log := bytes.Buffer{}
+	recorder, err := NewRecorder(&log, MapSpec{Kind: RectangleMapKind, Width: 3, Height: 3}, 0)
+	assert.Nil(t, err)
+
+	// when
+	_, orientationErr := recorder.Travel(0, 0, "O", "A")
+	_, coordinateErr := recorder.Travel(3, 0, North, "A")
+
+	//then
+	assert.ErrorIs(t, orientationErr, ErrInvalidOrientation)
+	assert.ErrorIs(t, coordinateErr, ErrInvalidCoordinate)
+	lines := strings.Split(strings.TrimSpace(log.String()), "\n")
+	assert.Equal(t, []string{
+		`{"op":"map","map":{"kind":"rectangle","width":3,"height":3}}`,
+		`{"op":"error","commands":"A","error":"O is not a valid orientation"}`,
+		`{"op":"error","commands":"A","error":"(3,0) are not valid x and y coordinates"}`,
+	}, lines)
+
+	report, err := Replay(strings.NewReader(log.String()))
+	assert.Nil(t, err)
+	assert.Equal(t, ReplayReport{Operations: 3}, report)
+}
+
+func TestRecorder_Geofences(t *testing.T) {
+
+	// given
+	log := bytes.Buffer{}
+	lander := GeofenceSpec{Name: "lander", Zone: MapSpec{Kind: RectangleMapKind, Width: 2, Height: 1}, Exclude: true}
+	recorder, err := NewRecorder(&log, MapSpec{Kind: SparseMapKind, Width: 3, Height: 3}, 0, lander)
+	assert.Nil(t, err)
+
+	// when
+	result, err := recorder.Travel(2, 0, West, "A")
+
+	//then
+	assert.Nil(t, err)
+	assert.Equal(t, "lander", result.Geofence)
+	assert.True(t, strings.HasPrefix(log.String(), `{"op":"map","map":{"kind":"sparse","width":3,"height":3},"geofences":[{"name":"lander","zone":{"kind":"rectangle","width":2,"height":1},"exclude":true}]}`))
+
+	report, err := Replay(strings.NewReader(log.String()))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, report.Travels)
+
+	// when
+	withoutGeofences := strings.Replace(log.String(), `,"geofences":[{"name":"lander","zone":{"kind":"rectangle","width":2,"height":1},"exclude":true}]`, "", 1)
+	_, err = Replay(strings.NewReader(withoutGeofences))
+
+	//then
+	assert.ErrorIs(t, err, ErrReplayDiverged)
+
+	// when
+	_, err = NewRecorder(&bytes.Buffer{}, MapSpec{Kind: RectangleMapKind, Width: 3, Height: 3}, 0, GeofenceSpec{Name: "broken", Zone: MapSpec{Kind: "hexagon"}})
+
+	//then
+	assert.ErrorIs(t, err, ErrInvalidMapSpec)
+}
+
+func TestReplay(t *testing.T) {
+
+	log := recordTravels(t, 4)
+	lines := strings.Split(strings.TrimSpace(log), "\n")
+
+	testCases := []struct {
+		name    string
+		log     string
+		asserts func(report ReplayReport, err error)
+	}{
+		{
+			name: "Recorded log replays",
+			log:  log,
+			asserts: func(report ReplayReport, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, ReplayReport{Operations: len(lines), Travels: 2}, report)
+			},
+		},
+		{
+			name: "Different autonomy diverges at the blocked step",
+			log:  strings.Replace(log, `"autonomy":4`, `"autonomy":0`, 1),
+			asserts: func(report ReplayReport, err error) {
+				divergence := &DivergenceError{}
+				assert.True(t, errors.As(err, &divergence))
+				assert.True(t, errors.Is(err, ErrReplayDiverged))
+				assert.Equal(t, 5, divergence.Line)
+				assert.Equal(t, lines[4], divergence.Expected)
+				assert.Equal(t, `{"op":"step","step":{"step":2,"command":"A","pose":{"x":1,"y":0,"orientation":"E"},"accepted":false}}`, divergence.Actual)
+			},
+		},
+		{
+			name: "Tampered result diverges",
+			log:  strings.Replace(log, `"result":{"valid":true,"pose":{"x":0,"y":1`, `"result":{"valid":true,"pose":{"x":9,"y":1`, 1),
+			asserts: func(report ReplayReport, err error) {
+				divergence := &DivergenceError{}
+				assert.True(t, errors.As(err, &divergence))
+				assert.Equal(t, len(lines), divergence.Line)
+				assert.Equal(t, `{"op":"result","result":{"valid":true,"pose":{"x":0,"y":1,"orientation":"W"}}}`, divergence.Actual)
+			},
+		},
+		{
+			name: "Truncated log diverges at its end",
+			log:  strings.Join(lines[:len(lines)-1], "\n"),
+			asserts: func(report ReplayReport, err error) {
+				divergence := &DivergenceError{}
+				assert.True(t, errors.As(err, &divergence))
+				assert.Equal(t, len(lines), divergence.Line)
+				assert.Equal(t, "", divergence.Expected)
+				assert.EqualError(t, err, fmt.Sprintf(`replay diverged at line %v: expected nothing but got {"op":"result","result":{"valid":true,"pose":{"x":0,"y":1,"orientation":"W"}}}`, len(lines)))
+			},
+		},
+		{
+			name: "Log without a map",
+			log:  strings.Join(lines[1:], "\n"),
+			asserts: func(report ReplayReport, err error) {
+				assert.EqualError(t, err, "line 1: the log must start with a map operation")
+			},
+		},
+		{
+			name: "Malformed line",
+			log:  lines[0] + "\n{",
+			asserts: func(report ReplayReport, err error) {
+				assert.ErrorContains(t, err, "line 2 is not a valid operation")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			// when
+			report, err := Replay(strings.NewReader(tc.log))
+
+			//then
+			tc.asserts(report, err)
+		})
+	}
+}
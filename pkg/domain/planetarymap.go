@@ -4,6 +4,29 @@ type PlanetaryMap interface {
 	IsValid(xcoord, ycoord int) bool
 }
 
+// BoundedMap is implemented by maps that know the rectangle enclosing all their valid cells.
+// The boolean result is false when the map has no edges.
+type BoundedMap interface {
+	Bounds() (Rectangle, bool)
+}
+
+// MapBounds returns the rectangle enclosing all valid cells of the map, if the map is bounded.
+func MapBounds(m PlanetaryMap) (Rectangle, bool) {
+
+	bounded, ok := m.(BoundedMap)
+	if !ok {
+		return Rectangle{}, false
+	}
+
+	return bounded.Bounds()
+}
+
+// Coordinate is a pair of x and y values on a PlanetaryMap.
+type Coordinate struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
 // IsValid validates a pair of x and y coordinates checking against map's width and height.
 func (m *Map) IsValid(xCoordinate, yCoordinate int) bool {
 
@@ -18,6 +41,11 @@ func (m *Map) IsValid(xCoordinate, yCoordinate int) bool {
 	return true
 }
 
+// Bounds returns the rectangle covered by the map.
+func (m *Map) Bounds() (Rectangle, bool) {
+	return Rectangle{X: 0, Y: 0, Width: m.width, Height: m.height}, true
+}
+
 func NewPlanetaryMap(width, height int) *PlanetaryMap {
 
 	m := NewMap(width, height)
@@ -39,3 +67,17 @@ func NewMap(width, height int) *Map {
 
 	return &newMap
 }
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -219,3 +219,33 @@ func TestIsValid(t *testing.T) {
 		})
 	}
 }
+
+func TestMapBounds(t *testing.T) {
+
+	testCases := []struct {
+		name           string
+		planetaryMap   PlanetaryMap
+		expectedBounds Rectangle
+		expectedOk     bool
+	}{
+		{name: "Map", planetaryMap: NewMap(4, 3), expectedBounds: Rectangle{0, 0, 4, 3}, expectedOk: true},
+		{name: "Polygon map", planetaryMap: NewPolygonMap(Coordinate{-1, 2}, Coordinate{5, 2}, Coordinate{1, 7}), expectedBounds: Rectangle{-1, 2, 6, 5}, expectedOk: true},
+		{name: "Rectangle set map", planetaryMap: NewRectangleSetMap(Rectangle{0, 0, 2, 2}, Rectangle{5, 6, 1, 1}).Difference(Rectangle{-10, -10, 100, 100}), expectedBounds: Rectangle{0, 0, 6, 7}, expectedOk: true},
+		{name: "Sparse map", planetaryMap: NewSparseMap(10, 20), expectedBounds: Rectangle{0, 0, 10, 20}, expectedOk: true},
+		{name: "Unbounded sparse map", planetaryMap: NewUnboundedSparseMap(), expectedOk: false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			pm := tt.planetaryMap
+
+			// when
+			bounds, ok := MapBounds(pm)
+
+			//then
+			assert.Equal(t, tt.expectedOk, ok)
+			assert.Equal(t, tt.expectedBounds, bounds)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package rover
+
+// PolygonMap is a PlanetaryMap whose boundary is a simple polygon described by its vertices.
+// A cell is considered inside the map when its centre (x+0.5, y+0.5) falls inside the polygon.
+type PolygonMap struct {
+	vertices []Coordinate
+}
+
+// NewPolygonMap creates a polygon shaped map. Vertices are grid corners given in order, either clockwise or
+// counterclockwise, and the polygon is closed automatically between the last and the first vertex.
+func NewPolygonMap(vertices ...Coordinate) *PolygonMap {
+
+	newMap := PolygonMap{
+		vertices: append([]Coordinate(nil), vertices...),
+	}
+
+	return &newMap
+}
+
+// IsValid validates a pair of x and y coordinates checking if the centre of the cell is inside the polygon.
+func (m *PolygonMap) IsValid(xCoordinate, yCoordinate int) bool {
+
+	centreX := float64(xCoordinate) + 0.5
+	centreY := float64(yCoordinate) + 0.5
+
+	// Ray casting: count how many edges a horizontal ray going right from the centre crosses.
+	inside := false
+	for i, j := 0, len(m.vertices)-1; i < len(m.vertices); j, i = i, i+1 {
+		xi, yi := float64(m.vertices[i].X), float64(m.vertices[i].Y)
+		xj, yj := float64(m.vertices[j].X), float64(m.vertices[j].Y)
+
+		if (yi > centreY) != (yj > centreY) && centreX < (xj-xi)*(centreY-yi)/(yj-yi)+xi {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
+
+// Bounds returns the smallest rectangle enclosing the polygon.
+func (m *PolygonMap) Bounds() (Rectangle, bool) {
+
+	if len(m.vertices) == 0 {
+		return Rectangle{}, true
+	}
+
+	minX, minY := m.vertices[0].X, m.vertices[0].Y
+	maxX, maxY := minX, minY
+	for _, v := range m.vertices[1:] {
+		minX, maxX = minInt(minX, v.X), maxInt(maxX, v.X)
+		minY, maxY = minInt(minY, v.Y), maxInt(maxY, v.Y)
+	}
+
+	return Rectangle{X: minX, Y: minY, Width: maxX - minX, Height: maxY - minY}, true
+}
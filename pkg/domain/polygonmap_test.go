@@ -0,0 +1,88 @@
+package rover
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPolygonMapConstructor(t *testing.T) {
+
+	vertices := []Coordinate{{0, 0}, {4, 0}, {4, 4}}
+	pm := NewPolygonMap(vertices...)
+
+	assert.NotNil(t, pm, "The new polygon map method returned nil")
+	assert.Len(t, pm.vertices, 3)
+
+	// The map keeps its own copy of the vertices.
+	vertices[0] = Coordinate{10, 10}
+	assert.Equal(t, Coordinate{0, 0}, pm.vertices[0])
+}
+
+func TestPolygonMap_IsValid(t *testing.T) {
+
+	// L shaped landing zone:
+	//
+	//	4 ##..
+	//	3 ##..
+	//	2 ####
+	//	1 ####
+	//	0 ####
+	//	  0123
+	lShape := NewPolygonMap(Coordinate{0, 0}, Coordinate{4, 0}, Coordinate{4, 3}, Coordinate{2, 3}, Coordinate{2, 5}, Coordinate{0, 5})
+
+	// Triangle whose hypotenuse cuts the cells of the diagonal in half.
+	triangle := NewPolygonMap(Coordinate{0, 0}, Coordinate{4, 0}, Coordinate{0, 4})
+
+	testCases := []struct {
+		name           string
+		planetaryMap   *PolygonMap
+		xCoordinate    int
+		yCoordinate    int
+		expectedResult bool
+	}{
+		{name: "Should be In when bottom left corner", planetaryMap: lShape, xCoordinate: 0, yCoordinate: 0, expectedResult: true},
+		{name: "Should be In when bottom right corner", planetaryMap: lShape, xCoordinate: 3, yCoordinate: 0, expectedResult: true},
+		{name: "Should be In when top of the narrow part", planetaryMap: lShape, xCoordinate: 1, yCoordinate: 4, expectedResult: true},
+		{name: "Should be Out when in the notch", planetaryMap: lShape, xCoordinate: 2, yCoordinate: 3, expectedResult: false},
+		{name: "Should be Out when above the wide part", planetaryMap: lShape, xCoordinate: 3, yCoordinate: 4, expectedResult: false},
+		{name: "Should be Out when left of the polygon", planetaryMap: lShape, xCoordinate: -1, yCoordinate: 1, expectedResult: false},
+		{name: "Should be Out when right of the polygon", planetaryMap: lShape, xCoordinate: 4, yCoordinate: 1, expectedResult: false},
+		{name: "Should be In when centre below the hypotenuse", planetaryMap: triangle, xCoordinate: 1, yCoordinate: 1, expectedResult: true},
+		{name: "Should be Out when centre above the hypotenuse", planetaryMap: triangle, xCoordinate: 2, yCoordinate: 2, expectedResult: false},
+		{name: "Should be Out when polygon has no area", planetaryMap: NewPolygonMap(Coordinate{0, 0}, Coordinate{4, 0}), xCoordinate: 0, yCoordinate: 0, expectedResult: false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+
+			// given
+			pm := tt.planetaryMap
+
+			// when
+			result := pm.IsValid(tt.xCoordinate, tt.yCoordinate)
+
+			//then
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
+
+func TestPolygonMap_Travel(t *testing.T) {
+	//Given
+	pm := NewPolygonMap(Coordinate{0, 0}, Coordinate{4, 0}, Coordinate{4, 3}, Coordinate{2, 3}, Coordinate{2, 5}, Coordinate{0, 5})
+	rover := NewRover(pm)
+
+	//When
+	output, err := rover.Travel(3, 0, North, "AAA")
+
+	//Then
+	assert.Nil(t, err)
+	assert.Equal(t, "False, N, (3,2)", output)
+
+	//When
+	output, err = rover.Travel(0, 0, North, "AAAARA")
+
+	//Then
+	assert.Nil(t, err)
+	assert.Equal(t, "True, E, (1,4)", output)
+}
@@ -0,0 +1,166 @@
+package rover
+
+import "sort"
+
+// ReachableCells returns every valid cell of a bounded map a Rover can reach from a cell, sorted by row and
+// column.
+func ReachableCells(m PlanetaryMap, from Coordinate) ([]Coordinate, error) {
+
+	if m == nil {
+		return nil, ErrMapNotInitialized
+	}
+
+	bounds, ok := MapBounds(m)
+	if !ok {
+		return nil, ErrUnboundedMap
+	}
+
+	if !m.IsValid(from.X, from.Y) {
+		return nil, &InvalidCoordinateError{X: from.X, Y: from.Y}
+	}
+
+	reachable := reachableCells(m, from, func(c Coordinate) bool { return bounds.Contains(c.X, c.Y) })
+
+	cells := make([]Coordinate, 0, len(reachable))
+	for c := range reachable {
+		cells = append(cells, c)
+	}
+	sortCoordinates(cells)
+
+	return cells, nil
+}
+
+// SweptBounds returns the smallest rectangle holding every cell a list of commands written with the English
+// alphabet goes through, relative to a start at (0,0) with the given orientation.
+func SweptBounds(listOfCommands string, orientation CardinalPoint) (Rectangle, error) {
+
+	offsets, err := planOffsets(listOfCommands, orientation)
+	if err != nil {
+		return Rectangle{}, err
+	}
+
+	return boundsOf(offsets), nil
+}
+
+// ValidStarts returns every cell of a bounded map from which a list of commands written with the English alphabet
+// can be executed with the given orientation without being rejected, sorted by row and column.
+func ValidStarts(m PlanetaryMap, listOfCommands string, orientation CardinalPoint) ([]Coordinate, error) {
+
+	if m == nil {
+		return nil, ErrMapNotInitialized
+	}
+
+	bounds, ok := MapBounds(m)
+	if !ok {
+		return nil, ErrUnboundedMap
+	}
+
+	offsets, err := planOffsets(listOfCommands, orientation)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the starts keeping the swept rectangle inside the map's bounds need to be checked cell by cell.
+	swept := boundsOf(offsets)
+	starts := []Coordinate{}
+	for y := bounds.Y - swept.Y; y+swept.Y+swept.Height <= bounds.Y+bounds.Height; y++ {
+		for x := bounds.X - swept.X; x+swept.X+swept.Width <= bounds.X+bounds.Width; x++ {
+			if validFrom(m, Coordinate{X: x, Y: y}, offsets) {
+				starts = append(starts, Coordinate{X: x, Y: y})
+			}
+		}
+	}
+
+	return starts, nil
+}
+
+// ReachableStarts returns the valid starts of a list of commands, as ValidStarts does, that a Rover can reach
+// from a cell before executing it.
+func ReachableStarts(m PlanetaryMap, from Coordinate, listOfCommands string, orientation CardinalPoint) ([]Coordinate, error) {
+
+	starts, err := ValidStarts(m, listOfCommands, orientation)
+	if err != nil {
+		return nil, err
+	}
+
+	reachable, err := ReachableCells(m, from)
+	if err != nil {
+		return nil, err
+	}
+
+	reachableSet := make(map[Coordinate]bool, len(reachable))
+	for _, c := range reachable {
+		reachableSet[c] = true
+	}
+
+	kept := []Coordinate{}
+	for _, c := range starts {
+		if reachableSet[c] {
+			kept = append(kept, c)
+		}
+	}
+
+	return kept, nil
+}
+
+// planOffsets returns the distinct cells a list of commands goes through, starting with (0,0), relative to the
+// start.
+func planOffsets(listOfCommands string, orientation CardinalPoint) ([]Coordinate, error) {
+
+	commands, err := convertStringToCommands(listOfCommands)
+	if err != nil {
+		return nil, err
+	}
+
+	if !orientation.IsValid() {
+		return nil, &InvalidOrientationError{Orientation: orientation}
+	}
+
+	offsets := []Coordinate{{}}
+	seen := map[Coordinate]bool{{}: true}
+	pose := Pose{Orientation: orientation}
+	for _, command := range commands {
+		pose = pose.next(command)
+		cell := Coordinate{X: pose.X, Y: pose.Y}
+		if !seen[cell] {
+			seen[cell] = true
+			offsets = append(offsets, cell)
+		}
+	}
+
+	return offsets, nil
+}
+
+// boundsOf returns the smallest rectangle holding every cell.
+func boundsOf(cells []Coordinate) Rectangle {
+
+	left, right, bottom, top := cells[0].X, cells[0].X, cells[0].Y, cells[0].Y
+	for _, c := range cells[1:] {
+		left, right = minInt(left, c.X), maxInt(right, c.X)
+		bottom, top = minInt(bottom, c.Y), maxInt(top, c.Y)
+	}
+
+	return Rectangle{X: left, Y: bottom, Width: right - left + 1, Height: top - bottom + 1}
+}
+
+// validFrom checks that every cell is valid on the map when the offsets are applied to the start.
+func validFrom(m PlanetaryMap, start Coordinate, offsets []Coordinate) bool {
+
+	for _, offset := range offsets {
+		if !m.IsValid(start.X+offset.X, start.Y+offset.Y) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sortCoordinates sorts cells by row and then by column.
+func sortCoordinates(cells []Coordinate) {
+	sort.Slice(cells, func(i, j int) bool {
+		if cells[i].Y != cells[j].Y {
+			return cells[i].Y < cells[j].Y
+		}
+		return cells[i].X < cells[j].X
+	})
+}
@@ -0,0 +1,252 @@
+package rover
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReachableCells_Islands(t *testing.T) {
+
+	// Two islands joined at the bottom row, and a lone cell at (4,2):
+	//
+	//	2 ..#.#.
+	//	1 ..##..
+	//	0 ...#..
+	//	  012345
+	pm := NewSparseMap(6, 3)
+	for _, c := range []Coordinate{{3, 0}, {2, 1}, {3, 1}, {2, 2}, {4, 2}} {
+		pm.SetObstacle(c.X, c.Y)
+	}
+
+	testCases := []struct {
+		name    string
+		m       PlanetaryMap
+		from    Coordinate
+		asserts func(cells []Coordinate, err error)
+	}{
+		{
+			name: "Left island",
+			m:    pm,
+			from: Coordinate{0, 2},
+			asserts: func(cells []Coordinate, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, []Coordinate{{0, 0}, {1, 0}, {2, 0}, {0, 1}, {1, 1}, {0, 2}, {1, 2}}, cells)
+			},
+		},
+		{
+			name: "Lone cell",
+			m:    pm,
+			from: Coordinate{3, 2},
+			asserts: func(cells []Coordinate, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, []Coordinate{{3, 2}}, cells)
+			},
+		},
+		{
+			name: "From an obstacle",
+			m:    pm,
+			from: Coordinate{3, 0},
+			asserts: func(cells []Coordinate, err error) {
+				assert.ErrorIs(t, err, ErrInvalidCoordinate)
+			},
+		},
+		{
+			name: "Unbounded map",
+			m:    NewUnboundedSparseMap(),
+			asserts: func(cells []Coordinate, err error) {
+				assert.ErrorIs(t, err, ErrUnboundedMap)
+			},
+		},
+		{
+			name: "Nil map",
+			asserts: func(cells []Coordinate, err error) {
+				assert.ErrorIs(t, err, ErrMapNotInitialized)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			// when
+			cells, err := ReachableCells(tc.m, tc.from)
+
+			//then
+			tc.asserts(cells, err)
+		})
+	}
+}
+
+func TestSweptBounds(t *testing.T) {
+
+	testCases := []struct {
+		name           string
+		listOfCommands string
+		orientation    CardinalPoint
+		asserts        func(bounds Rectangle, err error)
+	}{
+		{
+			name:           "Only turns",
+			listOfCommands: "LRL",
+			orientation:    North,
+			asserts: func(bounds Rectangle, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, Rectangle{0, 0, 1, 1}, bounds)
+			},
+		},
+		{
+			name:           "Facing North",
+			listOfCommands: "AARAAALA",
+			orientation:    North,
+			asserts: func(bounds Rectangle, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, Rectangle{0, 0, 4, 4}, bounds)
+			},
+		},
+		{
+			name:           "Same plan facing South",
+			listOfCommands: "AARAAALA",
+			orientation:    South,
+			asserts: func(bounds Rectangle, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, Rectangle{-3, -3, 4, 4}, bounds)
+			},
+		},
+		{
+			name:           "Invalid command",
+			listOfCommands: "AX",
+			orientation:    North,
+			asserts: func(bounds Rectangle, err error) {
+				assert.ErrorIs(t, err, ErrInvalidCommand)
+			},
+		},
+		{
+			name:           "Invalid orientation",
+			listOfCommands: "A",
+			orientation:    "X",
+			asserts: func(bounds Rectangle, err error) {
+				assert.ErrorIs(t, err, ErrInvalidOrientation)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			// when
+			bounds, err := SweptBounds(tc.listOfCommands, tc.orientation)
+
+			//then
+			tc.asserts(bounds, err)
+		})
+	}
+}
+
+func TestValidStarts(t *testing.T) {
+
+	// Rock at (1,1) on a 4x3 map:
+	//
+	//	2 ....
+	//	1 .#..
+	//	0 ....
+	//	  0123
+	rocky := NewSparseMap(4, 3)
+	rocky.SetObstacle(1, 1)
+
+	testCases := []struct {
+		name           string
+		m              PlanetaryMap
+		listOfCommands string
+		orientation    CardinalPoint
+		asserts        func(starts []Coordinate, err error)
+	}{
+		{
+			name:           "Rectangle map",
+			m:              NewMap(3, 3),
+			listOfCommands: "AARA",
+			orientation:    North,
+			asserts: func(starts []Coordinate, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, []Coordinate{{0, 0}, {1, 0}}, starts)
+			},
+		},
+		{
+			name:           "Around a rock",
+			m:              rocky,
+			listOfCommands: "RA",
+			orientation:    North,
+			asserts: func(starts []Coordinate, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, []Coordinate{{0, 0}, {1, 0}, {2, 0}, {2, 1}, {0, 2}, {1, 2}, {2, 2}}, starts)
+			},
+		},
+		{
+			name:           "Plan larger than the map",
+			m:              NewMap(3, 3),
+			listOfCommands: "AAA",
+			orientation:    East,
+			asserts: func(starts []Coordinate, err error) {
+				assert.Nil(t, err)
+				assert.Empty(t, starts)
+			},
+		},
+		{
+			name:           "Unbounded map",
+			m:              NewUnboundedSparseMap(),
+			listOfCommands: "A",
+			orientation:    North,
+			asserts: func(starts []Coordinate, err error) {
+				assert.ErrorIs(t, err, ErrUnboundedMap)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			// when
+			starts, err := ValidStarts(tc.m, tc.listOfCommands, tc.orientation)
+
+			//then
+			tc.asserts(starts, err)
+
+			// Every valid start must pass Travel, and every other cell must fail.
+			if err != nil {
+				return
+			}
+			bounds, _ := MapBounds(tc.m)
+			r := NewRover(tc.m)
+			for y := bounds.Y; y < bounds.Y+bounds.Height; y++ {
+				for x := bounds.X; x < bounds.X+bounds.Width; x++ {
+					result, travelErr := r.Execute(x, y, tc.orientation, tc.listOfCommands)
+					assert.Equal(t, travelErr == nil && result.Valid, containsCoordinate(starts, Coordinate{x, y}), "start (%v,%v)", x, y)
+				}
+			}
+		})
+	}
+}
+
+func TestReachableStarts(t *testing.T) {
+
+	// given
+	pm := NewRectangleSetMap(Rectangle{0, 0, 3, 3}, Rectangle{4, 0, 3, 3})
+
+	// when
+	starts, err := ReachableStarts(pm, Coordinate{5, 1}, "AA", North)
+
+	//then
+	assert.Nil(t, err)
+	assert.Equal(t, []Coordinate{{4, 0}, {5, 0}, {6, 0}}, starts)
+}
+
+func containsCoordinate(cells []Coordinate, cell Coordinate) bool {
+
+	for _, c := range cells {
+		if c == cell {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,89 @@
+package rover
+
+// Rectangle is an axis aligned area of cells whose bottom left cell is (X,Y).
+type Rectangle struct {
+	X      int `json:"x"`
+	Y      int `json:"y"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// Contains checks if the cell at x and y coordinates is one of the rectangle's cells.
+func (r Rectangle) Contains(xCoordinate, yCoordinate int) bool {
+	return xCoordinate >= r.X && xCoordinate < r.X+r.Width && yCoordinate >= r.Y && yCoordinate < r.Y+r.Height
+}
+
+// union returns the smallest rectangle enclosing both rectangles. An empty rectangle is ignored.
+func (r Rectangle) union(other Rectangle) Rectangle {
+
+	if r.Width <= 0 || r.Height <= 0 {
+		return other
+	}
+
+	minX, minY := minInt(r.X, other.X), minInt(r.Y, other.Y)
+	maxX, maxY := maxInt(r.X+r.Width, other.X+other.Width), maxInt(r.Y+r.Height, other.Y+other.Height)
+
+	return Rectangle{X: minX, Y: minY, Width: maxX - minX, Height: maxY - minY}
+}
+
+type rectangleOperation struct {
+	rectangle Rectangle
+	include   bool
+}
+
+// RectangleSetMap is a PlanetaryMap built from unions and differences of rectangles.
+// Operations are applied in the order they were added, so a rectangle can punch a hole in a previous union
+// and a later union can fill part of that hole again.
+type RectangleSetMap struct {
+	operations []rectangleOperation
+}
+
+// NewRectangleSetMap creates a map made of the union of the given rectangles.
+func NewRectangleSetMap(rectangles ...Rectangle) *RectangleSetMap {
+
+	newMap := RectangleSetMap{}
+	for _, r := range rectangles {
+		newMap.Union(r)
+	}
+
+	return &newMap
+}
+
+// Union adds the cells of the rectangle to the map.
+func (m *RectangleSetMap) Union(r Rectangle) *RectangleSetMap {
+	m.operations = append(m.operations, rectangleOperation{rectangle: r, include: true})
+	return m
+}
+
+// Difference removes the cells of the rectangle from the map.
+func (m *RectangleSetMap) Difference(r Rectangle) *RectangleSetMap {
+	m.operations = append(m.operations, rectangleOperation{rectangle: r, include: false})
+	return m
+}
+
+// IsValid validates a pair of x and y coordinates. The last operation whose rectangle contains the cell decides
+// if the cell is part of the map.
+func (m *RectangleSetMap) IsValid(xCoordinate, yCoordinate int) bool {
+
+	for i := len(m.operations) - 1; i >= 0; i-- {
+		if m.operations[i].rectangle.Contains(xCoordinate, yCoordinate) {
+			return m.operations[i].include
+		}
+	}
+
+	return false
+}
+
+// Bounds returns the smallest rectangle enclosing every rectangle added with Union.
+func (m *RectangleSetMap) Bounds() (Rectangle, bool) {
+
+	bounds := Rectangle{}
+	for _, op := range m.operations {
+		if !op.include || op.rectangle.Width <= 0 || op.rectangle.Height <= 0 {
+			continue
+		}
+		bounds = bounds.union(op.rectangle)
+	}
+
+	return bounds, true
+}
@@ -0,0 +1,92 @@
+package rover
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRectangle_Contains(t *testing.T) {
+
+	r := Rectangle{X: 1, Y: 2, Width: 3, Height: 2}
+
+	assert.True(t, r.Contains(1, 2))
+	assert.True(t, r.Contains(3, 3))
+	assert.False(t, r.Contains(0, 2))
+	assert.False(t, r.Contains(4, 2))
+	assert.False(t, r.Contains(1, 1))
+	assert.False(t, r.Contains(1, 4))
+	assert.False(t, Rectangle{X: 0, Y: 0}.Contains(0, 0))
+}
+
+func TestRectangleSetMapConstructor(t *testing.T) {
+
+	pm := NewRectangleSetMap(Rectangle{0, 0, 2, 2}, Rectangle{5, 5, 1, 1})
+
+	assert.NotNil(t, pm, "The new rectangle set map method returned nil")
+	assert.Len(t, pm.operations, 2)
+	assert.True(t, pm.operations[0].include)
+	assert.True(t, pm.operations[1].include)
+}
+
+func TestRectangleSetMap_IsValid(t *testing.T) {
+
+	// 6x6 square with a 2x2 hole in the middle, and a single cell patched back into the hole.
+	withHole := NewRectangleSetMap(Rectangle{0, 0, 6, 6}).
+		Difference(Rectangle{2, 2, 2, 2}).
+		Union(Rectangle{3, 3, 1, 1})
+
+	// Two separate landing zones.
+	twoZones := NewRectangleSetMap(Rectangle{0, 0, 2, 2}, Rectangle{10, 10, 2, 2})
+
+	testCases := []struct {
+		name           string
+		planetaryMap   *RectangleSetMap
+		xCoordinate    int
+		yCoordinate    int
+		expectedResult bool
+	}{
+		{name: "Should be In when bottom left corner", planetaryMap: withHole, xCoordinate: 0, yCoordinate: 0, expectedResult: true},
+		{name: "Should be In when top right corner", planetaryMap: withHole, xCoordinate: 5, yCoordinate: 5, expectedResult: true},
+		{name: "Should be Out when in the hole", planetaryMap: withHole, xCoordinate: 2, yCoordinate: 2, expectedResult: false},
+		{name: "Should be In when in the patched cell", planetaryMap: withHole, xCoordinate: 3, yCoordinate: 3, expectedResult: true},
+		{name: "Should be Out when outside every rectangle", planetaryMap: withHole, xCoordinate: 6, yCoordinate: 0, expectedResult: false},
+		{name: "Should be In when in first zone", planetaryMap: twoZones, xCoordinate: 1, yCoordinate: 1, expectedResult: true},
+		{name: "Should be In when in second zone", planetaryMap: twoZones, xCoordinate: 11, yCoordinate: 10, expectedResult: true},
+		{name: "Should be Out when between zones", planetaryMap: twoZones, xCoordinate: 5, yCoordinate: 5, expectedResult: false},
+		{name: "Should be Out when map is empty", planetaryMap: NewRectangleSetMap(), xCoordinate: 0, yCoordinate: 0, expectedResult: false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+
+			// given
+			pm := tt.planetaryMap
+
+			// when
+			result := pm.IsValid(tt.xCoordinate, tt.yCoordinate)
+
+			//then
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
+
+func TestRectangleSetMap_Travel(t *testing.T) {
+	//Given
+	pm := NewRectangleSetMap(Rectangle{0, 0, 5, 5}).Difference(Rectangle{2, 0, 1, 3})
+	rover := NewRover(pm)
+
+	//When
+	output, err := rover.Travel(0, 1, East, "AAA")
+
+	//Then
+	assert.Nil(t, err)
+	assert.Equal(t, "False, E, (1,1)", output)
+
+	//When
+	output, err = rover.Travel(0, 1, North, "AARAAAARAA")
+
+	//Then
+	assert.Nil(t, err)
+	assert.Equal(t, "True, S, (4,1)", output)
+}
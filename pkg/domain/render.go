@@ -0,0 +1,63 @@
+package rover
+
+import (
+	"strings"
+)
+
+// renderWindow is the number of cells drawn around the Rover, in every direction, for maps without bounds.
+const renderWindow = 10
+
+// orientationMarks are the characters used to draw a Rover facing each CardinalPoint.
+var orientationMarks = map[CardinalPoint]byte{
+	North: '^',
+	East:  '>',
+	South: 'v',
+	West:  '<',
+}
+
+// RenderMap draws the map with the top row first, and the Rover at the given pose when it is not nil.
+// Maps without bounds are drawn around the Rover.
+//
+//	-. for a valid cell.
+//	-# for an invalid cell.
+//	-^ > v < for the Rover facing North, East, South or West.
+func RenderMap(m PlanetaryMap, pose *Pose) string {
+
+	bounds, ok := MapBounds(m)
+	if !ok {
+		centre := Coordinate{}
+		if pose != nil {
+			centre = Coordinate{X: pose.X, Y: pose.Y}
+		}
+		bounds = Rectangle{X: centre.X - renderWindow, Y: centre.Y - renderWindow, Width: 2*renderWindow + 1, Height: 2*renderWindow + 1}
+	}
+
+	return RenderMapArea(m, pose, bounds)
+}
+
+// RenderMapArea draws the cells of the area of the map with the top row first, and the Rover at the given pose when
+// it is not nil and inside the area. It uses the same characters as RenderMap, so cells of the area out of the map
+// are drawn as invalid.
+func RenderMapArea(m PlanetaryMap, pose *Pose, area Rectangle) string {
+
+	var sb strings.Builder
+	for y := area.Y + area.Height - 1; y >= area.Y; y-- {
+		for x := area.X; x < area.X+area.Width; x++ {
+			switch {
+			case pose != nil && pose.X == x && pose.Y == y:
+				mark, ok := orientationMarks[pose.Orientation]
+				if !ok {
+					mark = '?'
+				}
+				sb.WriteByte(mark)
+			case m.IsValid(x, y):
+				sb.WriteByte('.')
+			default:
+				sb.WriteByte('#')
+			}
+		}
+		sb.WriteByte('\n')
+	}
+
+	return sb.String()
+}
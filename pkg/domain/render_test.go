@@ -0,0 +1,85 @@
+package rover
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestRenderMap(t *testing.T) {
+
+	withObstacle := NewSparseMap(4, 3)
+	withObstacle.SetObstacle(2, 1)
+
+	testCases := []struct {
+		name         string
+		planetaryMap PlanetaryMap
+		pose         *Pose
+		asserts      func(output string)
+	}{
+		{
+			name:         "Map without rover",
+			planetaryMap: withObstacle,
+			pose:         nil,
+			asserts: func(output string) {
+				assert.Equal(t, "....\n..#.\n....\n", output)
+			},
+		},
+		{
+			name:         "Map with rover facing East",
+			planetaryMap: withObstacle,
+			pose:         &Pose{1, 1, East},
+			asserts: func(output string) {
+				assert.Equal(t, "....\n.>#.\n....\n", output)
+			},
+		},
+		{
+			name:         "Polygon map with rover facing South",
+			planetaryMap: NewPolygonMap(Coordinate{0, 0}, Coordinate{3, 0}, Coordinate{0, 3}),
+			pose:         &Pose{0, 2, South},
+			asserts: func(output string) {
+				assert.Equal(t, "v##\n.##\n..#\n", output)
+			},
+		},
+		{
+			name:         "Unbounded map is drawn around the rover",
+			planetaryMap: NewUnboundedSparseMap(),
+			pose:         &Pose{-100, 100, West},
+			asserts: func(output string) {
+				rows := strings.Split(strings.TrimSuffix(output, "\n"), "\n")
+				assert.Len(t, rows, 21)
+				assert.Equal(t, strings.Repeat(".", 10)+"<"+strings.Repeat(".", 10), rows[10])
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+
+			// when
+			output := RenderMap(tt.planetaryMap, tt.pose)
+
+			//then
+			tt.asserts(output)
+		})
+	}
+}
+
+func TestRenderMapArea(t *testing.T) {
+	//Given
+	withObstacle := NewSparseMap(4, 3)
+	withObstacle.SetObstacle(2, 1)
+
+	//When
+	output := RenderMapArea(withObstacle, &Pose{1, 1, North}, Rectangle{X: 1, Y: 0, Width: 4, Height: 2})
+
+	//Then
+	assert.Equal(t, "^#.#\n...#\n", output, "Cells out of the map must be drawn as invalid")
+
+	//When
+	output = RenderMapArea(withObstacle, &Pose{0, 0, North}, Rectangle{X: 2, Y: 1, Width: 1, Height: 1})
+
+	//Then
+	assert.Equal(t, "#\n", output, "A rover out of the area must not be drawn")
+}
@@ -0,0 +1,198 @@
+package rover
+
+// clockwise lists the cardinal points in the order the Rover goes through them when turning right.
+var clockwise = []CardinalPoint{North, East, South, West}
+
+// neighbourOffsets are the x and y offsets of the four cells next to a cell, in clockwise order from North.
+var neighbourOffsets = []Coordinate{{0, 1}, {1, 0}, {0, -1}, {-1, 0}}
+
+// planetaryMapFunc allows a plain function to be used as a PlanetaryMap.
+type planetaryMapFunc func(xCoordinate, yCoordinate int) bool
+
+func (f planetaryMapFunc) IsValid(xCoordinate, yCoordinate int) bool {
+	return f(xCoordinate, yCoordinate)
+}
+
+// next returns the pose after executing the command on an unbounded plane.
+func (p Pose) next(command Command) Pose {
+
+	index := turnIndex(p.Orientation)
+	if index == -1 {
+		return p
+	}
+
+	switch command {
+	case Left:
+		p.Orientation = clockwise[(index+3)%4]
+	case Right:
+		p.Orientation = clockwise[(index+1)%4]
+	case Advance:
+		p.X += neighbourOffsets[index].X
+		p.Y += neighbourOffsets[index].Y
+	}
+
+	return p
+}
+
+// turnIndex returns the position of the CardinalPoint in clockwise, or -1 when it is not valid.
+func turnIndex(cp CardinalPoint) int {
+
+	for i, v := range clockwise {
+		if v == cp {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// turnsBetween returns the shortest list of turn commands that changes the orientation from one CardinalPoint
+// to the other.
+func turnsBetween(from, to CardinalPoint) string {
+
+	switch (turnIndex(to) - turnIndex(from) + 4) % 4 {
+	case 1:
+		return string(Right)
+	case 2:
+		return string(Right + Right)
+	case 3:
+		return string(Left)
+	}
+
+	return ""
+}
+
+// orientationTowards returns the CardinalPoint that points from one cell to a neighbouring one.
+func orientationTowards(from, to Coordinate) CardinalPoint {
+
+	for i, offset := range neighbourOffsets {
+		if from.X+offset.X == to.X && from.Y+offset.Y == to.Y {
+			return clockwise[i]
+		}
+	}
+
+	return ""
+}
+
+// commandsAlongPath converts a path of neighbouring cells starting at the pose's cell into a list of commands,
+// and returns the pose the Rover will have at the end of the path.
+func commandsAlongPath(from Pose, path []Coordinate) (string, Pose) {
+
+	commands := make([]byte, 0, len(path)*2)
+	pose := from
+
+	for i := 1; i < len(path); i++ {
+		orientation := orientationTowards(path[i-1], path[i])
+		commands = append(commands, turnsBetween(pose.Orientation, orientation)...)
+		commands = append(commands, Advance...)
+		pose = Pose{X: path[i].X, Y: path[i].Y, Orientation: orientation}
+	}
+
+	return string(commands), pose
+}
+
+// findPath searches breadth first the shortest path of valid cells from a cell to the closest cell satisfying
+// isGoal. Only cells accepted by inSearch are explored. The path includes both ends, and nil is returned when
+// no goal can be reached.
+func findPath(m PlanetaryMap, from Coordinate, isGoal func(Coordinate) bool, inSearch func(Coordinate) bool) []Coordinate {
+
+	previous := map[Coordinate]Coordinate{from: from}
+	queue := []Coordinate{from}
+
+	for len(queue) > 0 {
+		cell := queue[0]
+		queue = queue[1:]
+
+		if isGoal(cell) {
+			path := []Coordinate{cell}
+			for cell != from {
+				cell = previous[cell]
+				path = append(path, cell)
+			}
+			for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+				path[i], path[j] = path[j], path[i]
+			}
+			return path
+		}
+
+		for _, offset := range neighbourOffsets {
+			next := Coordinate{X: cell.X + offset.X, Y: cell.Y + offset.Y}
+			if _, seen := previous[next]; seen || !inSearch(next) || !m.IsValid(next.X, next.Y) {
+				continue
+			}
+			previous[next] = cell
+			queue = append(queue, next)
+		}
+	}
+
+	return nil
+}
+
+// reachableCells returns every valid cell that can be reached from a cell without leaving the given area.
+func reachableCells(m PlanetaryMap, from Coordinate, inSearch func(Coordinate) bool) map[Coordinate]bool {
+
+	reachable := map[Coordinate]bool{}
+	if !inSearch(from) || !m.IsValid(from.X, from.Y) {
+		return reachable
+	}
+
+	reachable[from] = true
+	queue := []Coordinate{from}
+
+	for len(queue) > 0 {
+		cell := queue[0]
+		queue = queue[1:]
+
+		for _, offset := range neighbourOffsets {
+			next := Coordinate{X: cell.X + offset.X, Y: cell.Y + offset.Y}
+			if reachable[next] || !inSearch(next) || !m.IsValid(next.X, next.Y) {
+				continue
+			}
+			reachable[next] = true
+			queue = append(queue, next)
+		}
+	}
+
+	return reachable
+}
+
+// shortestCommands searches breadth first the shortest list of commands that takes a Rover from a pose to one
+// satisfying isGoal, only going through valid cells accepted by inSearch. It returns false when no goal can be
+// reached.
+func shortestCommands(m PlanetaryMap, from Pose, isGoal func(Pose) bool, inSearch func(Coordinate) bool) (string, Pose, bool) {
+
+	type visit struct {
+		previous Pose
+		command  Command
+	}
+
+	visited := map[Pose]visit{from: {}}
+	queue := []Pose{from}
+
+	for len(queue) > 0 {
+		pose := queue[0]
+		queue = queue[1:]
+
+		if isGoal(pose) {
+			commands := []byte{}
+			for end := pose; end != from; end = visited[end].previous {
+				commands = append(commands, visited[end].command...)
+			}
+			for i, j := 0, len(commands)-1; i < j; i, j = i+1, j-1 {
+				commands[i], commands[j] = commands[j], commands[i]
+			}
+			return string(commands), pose, true
+		}
+
+		for _, command := range []Command{Advance, Left, Right} {
+			next := pose.next(command)
+			if _, seen := visited[next]; seen || !inSearch(Coordinate{X: next.X, Y: next.Y}) || !m.IsValid(next.X, next.Y) {
+				continue
+			}
+			visited[next] = visit{previous: pose, command: command}
+			queue = append(queue, next)
+		}
+	}
+
+	return "", Pose{}, false
+}
@@ -0,0 +1,188 @@
+package rover
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTurnsBetween(t *testing.T) {
+
+	assert.Equal(t, "", turnsBetween(North, North))
+	assert.Equal(t, "R", turnsBetween(North, East))
+	assert.Equal(t, "RR", turnsBetween(North, South))
+	assert.Equal(t, "L", turnsBetween(North, West))
+	assert.Equal(t, "R", turnsBetween(West, North))
+	assert.Equal(t, "L", turnsBetween(South, East))
+}
+
+func TestCommandsAlongPath(t *testing.T) {
+	//Given
+	path := []Coordinate{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}
+
+	//When
+	commands, pose := commandsAlongPath(Pose{0, 0, North}, path)
+
+	//Then
+	assert.Equal(t, "ARARARA", commands)
+	assert.Equal(t, Pose{0, 0, West}, pose)
+
+	//When
+	commands, pose = commandsAlongPath(Pose{3, 3, South}, []Coordinate{{3, 3}})
+
+	//Then
+	assert.Equal(t, "", commands)
+	assert.Equal(t, Pose{3, 3, South}, pose)
+}
+
+func TestFindPath(t *testing.T) {
+
+	// Wall with a single gap at the top:
+	//
+	//	3 .#..
+	//	2 .#..
+	//	1 .#..
+	//	0 ....
+	//	  0123
+	pm := NewSparseMap(4, 4)
+	pm.SetObstacle(1, 1)
+	pm.SetObstacle(1, 2)
+	pm.SetObstacle(1, 3)
+	everywhere := func(Coordinate) bool { return true }
+
+	testCases := []struct {
+		name     string
+		from     Coordinate
+		goal     Coordinate
+		inSearch func(Coordinate) bool
+		asserts  func(path []Coordinate)
+	}{
+		{
+			name:     "Around the wall",
+			from:     Coordinate{0, 3},
+			goal:     Coordinate{2, 3},
+			inSearch: everywhere,
+			asserts: func(path []Coordinate) {
+				assert.Len(t, path, 9)
+				assert.Equal(t, Coordinate{0, 3}, path[0])
+				assert.Equal(t, Coordinate{1, 0}, path[4])
+				assert.Equal(t, Coordinate{2, 3}, path[8])
+			},
+		},
+		{
+			name:     "Already at the goal",
+			from:     Coordinate{0, 0},
+			goal:     Coordinate{0, 0},
+			inSearch: everywhere,
+			asserts: func(path []Coordinate) {
+				assert.Equal(t, []Coordinate{{0, 0}}, path)
+			},
+		},
+		{
+			name:     "Goal is an obstacle",
+			from:     Coordinate{0, 0},
+			goal:     Coordinate{1, 1},
+			inSearch: everywhere,
+			asserts: func(path []Coordinate) {
+				assert.Nil(t, path)
+			},
+		},
+		{
+			name:     "Search area does not include the gap",
+			from:     Coordinate{0, 3},
+			goal:     Coordinate{2, 3},
+			inSearch: func(c Coordinate) bool { return c.Y > 0 },
+			asserts: func(path []Coordinate) {
+				assert.Nil(t, path)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			goal := tt.goal
+
+			// when
+			path := findPath(pm, tt.from, func(c Coordinate) bool { return c == goal }, tt.inSearch)
+
+			//then
+			tt.asserts(path)
+		})
+	}
+}
+
+func TestReachableCells(t *testing.T) {
+	//Given
+	pm := NewRectangleSetMap(Rectangle{0, 0, 2, 2}, Rectangle{3, 0, 2, 2})
+	everywhere := func(Coordinate) bool { return true }
+
+	//When
+	reachable := reachableCells(pm, Coordinate{0, 0}, everywhere)
+
+	//Then
+	assert.Len(t, reachable, 4)
+	assert.True(t, reachable[Coordinate{1, 1}])
+	assert.False(t, reachable[Coordinate{3, 0}])
+
+	//When
+	reachable = reachableCells(pm, Coordinate{2, 0}, everywhere)
+
+	//Then
+	assert.Len(t, reachable, 0)
+}
+
+func TestShortestCommands(t *testing.T) {
+
+	pm := NewMap(4, 4)
+	everywhere := func(Coordinate) bool { return true }
+
+	testCases := []struct {
+		name     string
+		from     Pose
+		goal     Pose
+		inSearch func(Coordinate) bool
+		asserts  func(commands string, end Pose, ok bool)
+	}{
+		{
+			name:     "Fewer turns beat a path found by cells",
+			from:     Pose{0, 0, East},
+			goal:     Pose{2, 2, North},
+			inSearch: everywhere,
+			asserts: func(commands string, end Pose, ok bool) {
+				assert.True(t, ok)
+				assert.Equal(t, "AALAA", commands)
+				assert.Equal(t, Pose{2, 2, North}, end)
+			},
+		},
+		{
+			name:     "Turning in place",
+			from:     Pose{1, 1, North},
+			goal:     Pose{1, 1, South},
+			inSearch: everywhere,
+			asserts: func(commands string, end Pose, ok bool) {
+				assert.True(t, ok)
+				assert.Equal(t, "LL", commands)
+			},
+		},
+		{
+			name:     "Goal outside the search area",
+			from:     Pose{0, 0, North},
+			goal:     Pose{3, 3, North},
+			inSearch: func(c Coordinate) bool { return c.X < 2 },
+			asserts: func(commands string, end Pose, ok bool) {
+				assert.False(t, ok)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			// when
+			commands, end, ok := shortestCommands(pm, tc.from, func(p Pose) bool { return p == tc.goal }, tc.inSearch)
+
+			//then
+			tc.asserts(commands, end, ok)
+		})
+	}
+}
@@ -1,12 +1,6 @@
 package rover
 
-import (
-	"errors"
-	"fmt"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
-	"strings"
-)
+import "unicode/utf8"
 
 type CardinalPoint string
 
@@ -40,11 +34,49 @@ func (c Command) IsValid() bool {
 	return c == Advance || c == Left || c == Right
 }
 
+// Pose is the position and orientation of a Rover on a PlanetaryMap.
+type Pose struct {
+	X           int           `json:"x"`
+	Y           int           `json:"y"`
+	Orientation CardinalPoint `json:"orientation"`
+}
+
+// StepEvent describes one step of a Rover's travel. The first event of every travel has Step 0 and no Command,
+// and reports the initial pose. Accepted is false when an Advance was rejected, which is always the last step.
+// Steps of a detour taken by an autonomous Rover have Detour set, and the Step of the command they replace.
+type StepEvent struct {
+	Step     int     `json:"step"`
+	Command  Command `json:"command,omitempty"`
+	Pose     Pose    `json:"pose"`
+	Accepted bool    `json:"accepted"`
+	Detour   bool    `json:"detour,omitempty"`
+}
+
+// StepListener is notified of every step of a Rover's travel.
+type StepListener func(event StepEvent)
+
 type Rover struct {
 	currentOrientation CardinalPoint
 	currentX           int
 	currentY           int
 	navigationMap      PlanetaryMap
+	listeners          []registeredListener
+	nextListenerID     int
+	alphabet           Alphabet
+	legacyFormatter    *LegacyFormatter
+	formatter          Formatter
+	output             Formatter
+	autonomyBudget     int
+	geofences          []registeredGeofence
+	nextGeofenceID     int
+	travelGeofences    []Geofence
+	commandTable       *[utf8.RuneSelf]Command
+	commandBuffer      []Command
+}
+
+type registeredListener struct {
+	id       int
+	listener StepListener
 }
 
 func NewRover(navigationMap PlanetaryMap) *Rover {
@@ -56,50 +88,214 @@ func NewRover(navigationMap PlanetaryMap) *Rover {
 	newRover := Rover{
 		navigationMap: navigationMap,
 	}
+	newRover.UseAlphabet(EnglishAlphabet)
 
 	return &newRover
 }
 
+// UseAlphabet sets the characters the Rover accepts for commands and orientations, and the language of its output.
+func (r *Rover) UseAlphabet(alphabet Alphabet) {
+	r.alphabet = alphabet
+	r.legacyFormatter = NewLegacyFormatter(alphabet)
+	r.commandTable = alphabet.asciiCommands()
+	r.UseFormatter(r.formatter)
+}
+
+// Alphabet returns the Alphabet the Rover uses for commands, orientations and its output.
+func (r *Rover) Alphabet() Alphabet {
+	return r.alphabet
+}
+
+// UseFormatter sets how Travel formats its result. A nil Formatter goes back to the legacy output.
+// Formatters implementing LocalizedFormatter are localized with the Rover's Alphabet.
+func (r *Rover) UseFormatter(formatter Formatter) {
+
+	r.formatter = formatter
+	switch f := formatter.(type) {
+	case nil:
+		r.output = r.legacyFormatter
+	case LocalizedFormatter:
+		r.output = f.Localize(r.alphabet)
+	default:
+		r.output = formatter
+	}
+}
+
+// Pose returns the Rover's current position and orientation.
+func (r *Rover) Pose() Pose {
+	return Pose{X: r.currentX, Y: r.currentY, Orientation: r.currentOrientation}
+}
+
+// OnStep registers a listener that will be notified of every step of the following travels.
+// The returned function removes the listener.
+func (r *Rover) OnStep(listener StepListener) func() {
+
+	r.nextListenerID++
+	id := r.nextListenerID
+	r.listeners = append(r.listeners, registeredListener{id: id, listener: listener})
+
+	return func() {
+		for i, registered := range r.listeners {
+			if registered.id == id {
+				r.listeners = append(r.listeners[:i:i], r.listeners[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// notify sends a step event to every registered listener.
+func (r *Rover) notify(step int, command Command, accepted bool) {
+	r.notifyEvent(StepEvent{Step: step, Command: command, Accepted: accepted})
+}
+
+// notifyEvent sends the event, with the Rover's current pose, to every registered listener.
+func (r *Rover) notifyEvent(event StepEvent) {
+
+	if len(r.listeners) == 0 {
+		return
+	}
+
+	event.Pose = r.Pose()
+	for _, registered := range r.listeners {
+		registered.listener(event)
+	}
+}
+
 // Travel will take an initial x and y position, an initial orientation and a list of commands.
 // Then will try to simulate the rover's travel on the map and return a formatted string with the result.
 func (r *Rover) Travel(initialX int, initialY int, initialOrientation CardinalPoint, listOfCommands string) (string, error) {
 
+	result, err := r.Execute(initialX, initialY, initialOrientation, listOfCommands)
+	if err != nil {
+		return "", err
+	}
+
+	return r.output.Format(result)
+}
+
+// AppendTravel works like Travel but appends the formatted result to dst and returns the extended buffer.
+// With a Formatter implementing AppendFormatter, like the legacy one, a valid list of commands is executed
+// without any allocation once dst has room for the result, which suits services validating many lists.
+func (r *Rover) AppendTravel(dst []byte, initialX int, initialY int, initialOrientation CardinalPoint, listOfCommands string) ([]byte, error) {
+
+	result, err := r.Execute(initialX, initialY, initialOrientation, listOfCommands)
+	if err != nil {
+		return dst, err
+	}
+
+	if formatter, ok := r.output.(AppendFormatter); ok {
+		return formatter.AppendFormat(dst, result), nil
+	}
+
+	output, err := r.output.Format(result)
+	if err != nil {
+		return dst, err
+	}
+
+	return append(dst, output...), nil
+}
+
+// Execute works like Travel but returns the result without formatting it.
+// The initial orientation is a CardinalPoint whatever the Rover's Alphabet is: letters typed by operators are
+// parsed with Alphabet.ParseOrientation before calling it.
+func (r *Rover) Execute(initialX int, initialY int, initialOrientation CardinalPoint, listOfCommands string) (TravelResult, error) {
+
 	if r == nil {
-		return "", errors.New("Rover was not initialized\n")
+		return TravelResult{}, ErrRoverNotInitialized
 	}
 
-	commands, err := convertStringToCommands(listOfCommands)
+	start, commands, err := r.prepare(initialX, initialY, initialOrientation, listOfCommands)
 	if err != nil {
-		return "", err
+		return TravelResult{}, err
 	}
 
-	if !r.navigationMap.IsValid(initialX, initialY) {
-		return "", errors.New(fmt.Sprintf("(%v,%v) are not valid x and y coordinates\n", initialX, initialY))
+	return r.run(start, commands), nil
+}
+
+// ValidatePose checks that the Rover can start a travel at the pose: its coordinates must be valid in the map and
+// its orientation a CardinalPoint.
+func (r *Rover) ValidatePose(pose Pose) error {
+
+	if r == nil {
+		return ErrRoverNotInitialized
+	}
+
+	return validateStart(r.navigationMap, pose)
+}
+
+// validateStart checks that a travel can start at the pose of the map.
+func validateStart(m PlanetaryMap, start Pose) error {
+
+	if !m.IsValid(start.X, start.Y) {
+		return &InvalidCoordinateError{X: start.X, Y: start.Y}
+	}
+
+	if !start.Orientation.IsValid() {
+		return &InvalidOrientationError{Orientation: start.Orientation}
+	}
+
+	return nil
+}
+
+// prepare validates the arguments of a travel and returns its start pose and commands, ready to be run.
+func (r *Rover) prepare(initialX int, initialY int, initialOrientation CardinalPoint, listOfCommands string) (Pose, []Command, error) {
+
+	commands, err := r.parseCommands(listOfCommands)
+	if err != nil {
+		return Pose{}, nil, err
 	}
 
-	if !initialOrientation.IsValid() {
-		return "", errors.New(fmt.Sprintf("%v is not a valid initial orientation\n", initialOrientation))
+	start := Pose{X: initialX, Y: initialY, Orientation: initialOrientation}
+	if err := r.ValidatePose(start); err != nil {
+		return Pose{}, nil, err
 	}
 
-	r.currentX = initialX
-	r.currentY = initialY
-	r.currentOrientation = initialOrientation
+	return start, commands, nil
+}
+
+// run places the Rover at the start pose and executes commands that were already validated.
+func (r *Rover) run(start Pose, commands []Command) TravelResult {
+
+	r.currentX = start.X
+	r.currentY = start.Y
+	r.currentOrientation = start.Orientation
+	r.notify(0, "", true)
 
-	for _, v := range commands {
+	var detours []Detour
+	for i := 0; i < len(commands); i++ {
+		v := commands[i]
 		switch v {
 		case Left:
 			r.TurnLeft()
 		case Right:
 			r.TurnRight()
 		case Advance:
-			err := r.Advance()
-			if err != nil {
-				return r.formatOutput(false), nil
+			// The coordinates are checked with canEnter instead of calling Advance, so a rejected move does
+			// not allocate an error.
+			x, y := r.ahead()
+			if r.canEnter(x, y) {
+				r.currentX, r.currentY = x, y
+				break
 			}
+
+			detour, ok := r.planDetour(commands, i)
+			if !ok || !r.followDetour(detour) {
+				r.notify(i+1, v, false)
+				result := TravelResult{Valid: false, Pose: r.Pose(), Detours: detours}
+				if g, violated := r.violatedGeofence(x, y); violated && r.navigationMap.IsValid(x, y) {
+					result.Geofence = g.Name
+				}
+				return result
+			}
+			detours = append(detours, detour)
+			i = detour.Rejoin - 1
+			continue
 		}
+		r.notify(i+1, v, true)
 	}
 
-	return r.formatOutput(true), nil
+	return TravelResult{Valid: true, Pose: r.Pose(), Detours: detours}
 }
 
 // TurnRight will change Rover's current orientation to the next CardinalPoint clockwise.
@@ -134,56 +330,99 @@ func (r *Rover) TurnLeft() {
 
 // Advance will move the Rover's position adding or subtracting 1 to the actual coordinates based on the currentOrientation.
 func (r *Rover) Advance() error {
-	newCoordinateX := r.currentX
-	newCoordinateY := r.currentY
+
+	newCoordinateX, newCoordinateY := r.ahead()
+	if err := r.checkEnter(newCoordinateX, newCoordinateY); err != nil {
+		return err
+	}
+
+	r.currentX = newCoordinateX
+	r.currentY = newCoordinateY
+
+	return nil
+}
+
+// ahead returns the coordinates the Rover would move to with an Advance.
+func (r *Rover) ahead() (int, int) {
 
 	switch r.currentOrientation {
 	case North:
-		newCoordinateY = r.currentY + 1
+		return r.currentX, r.currentY + 1
 	case West:
-		newCoordinateX = r.currentX - 1
+		return r.currentX - 1, r.currentY
 	case South:
-		newCoordinateY = r.currentY - 1
+		return r.currentX, r.currentY - 1
 	case East:
-		newCoordinateX = r.currentX + 1
+		return r.currentX + 1, r.currentY
 	}
 
-	if !r.navigationMap.IsValid(newCoordinateX, newCoordinateY) {
-		return errors.New(fmt.Sprintf("can not advance to (%v,%v)\n", newCoordinateX, newCoordinateY))
+	return r.currentX, r.currentY
+}
+
+// canEnter checks if the Rover is allowed to be at x and y coordinates.
+func (r *Rover) canEnter(xCoordinate, yCoordinate int) bool {
+
+	if !r.navigationMap.IsValid(xCoordinate, yCoordinate) {
+		return false
 	}
 
-	r.currentX = newCoordinateX
-	r.currentY = newCoordinateY
+	_, violated := r.violatedGeofence(xCoordinate, yCoordinate)
+	return !violated
+}
+
+// checkEnter tells why the Rover is not allowed to be at x and y coordinates: they are not valid on its map, or
+// one of its geofences forbids them.
+func (r *Rover) checkEnter(xCoordinate, yCoordinate int) error {
+
+	if !r.navigationMap.IsValid(xCoordinate, yCoordinate) {
+		return &OutOfBoundsError{X: xCoordinate, Y: yCoordinate}
+	}
+
+	if g, ok := r.violatedGeofence(xCoordinate, yCoordinate); ok {
+		return &GeofenceError{Geofence: g.Name, X: xCoordinate, Y: yCoordinate}
+	}
 
 	return nil
 }
 
-// convertStringToCommands will convert a string into a list of valid commands Rover commands.
-func convertStringToCommands(listOfCommands string) ([]Command, error) {
+// commandBufferLimit is the most commands a Rover keeps room for between travels. Longer lists are parsed into a
+// buffer that is let go afterwards, so a single huge travel does not pin its memory for the Rover's whole life.
+const commandBufferLimit = 1 << 16
+
+// parseCommands converts a list of commands written with the Rover's Alphabet like convertStringToCommands does,
+// reusing the Rover's command buffer so that nothing is allocated once it has grown to fit the list.
+// The returned slice is only valid until the next call.
+func (r *Rover) parseCommands(listOfCommands string) ([]Command, error) {
 
-	if listOfCommands == "" {
-		return nil, errors.New(fmt.Sprintf("list of commands is empty\n"))
+	commands, err := r.alphabet.appendCommands(r.commandBuffer[:0], listOfCommands, r.commandTable)
+	if err != nil {
+		return nil, err
 	}
 
-	commands := strings.Split(listOfCommands, "")
-	validatedCommands := make([]Command, 0)
+	r.commandBuffer = commands
+	if cap(commands) > commandBufferLimit {
+		r.commandBuffer = nil
+	}
 
-	for _, v := range commands {
-		command := Command(v)
-		if !command.IsValid() {
-			return nil, errors.New(fmt.Sprintf("%v is not a valid command\n", command))
-		}
+	return commands, nil
+}
 
-		validatedCommands = append(validatedCommands, command)
-	}
+// convertStringToCommands will convert a string into a list of valid Rover commands using the English alphabet.
+func convertStringToCommands(listOfCommands string) ([]Command, error) {
+	return EnglishAlphabet.convertStringToCommands(listOfCommands)
+}
 
-	return validatedCommands, nil
+// ValidateCommands checks a whole list of commands written with the English alphabet and reports every invalid
+// character at once. See Alphabet.ValidateCommands.
+func ValidateCommands(listOfCommands string) error {
+	return EnglishAlphabet.ValidateCommands(listOfCommands)
 }
 
-// formatOutput will format the return string to the requested specification.
+// formatOutput will format the Rover's current pose with the legacy output in the Rover's language.
 //
-//	-(True , N, (1,4) when the final destination is within the map's limit.
-//	-(False , N, (1,10) when the final destination falls out the map's limit.
+//	-True, N, (1,4) when the final destination is within the map's limit.
+//	-False, N, (1,10) when the final destination falls out the map's limit.
 func (r *Rover) formatOutput(stillInsideTheMap bool) string {
-	return fmt.Sprintf("%v, %v, (%v,%v)", cases.Title(language.English).String(fmt.Sprintf("%v", stillInsideTheMap)), r.currentOrientation, r.currentX, r.currentY)
+	output, _ := r.legacyFormatter.Format(TravelResult{Valid: stillInsideTheMap, Pose: r.Pose()})
+	return output
 }
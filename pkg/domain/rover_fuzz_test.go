@@ -0,0 +1,161 @@
+package rover
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/quick"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The seed corpus of the fuzz targets is in testdata/fuzz. Run them with, for example:
+//
+//	go test ./pkg/domain -run '^$' -fuzz FuzzTravel
+
+func FuzzConvertStringToCommands(f *testing.F) {
+
+	f.Fuzz(func(t *testing.T, listOfCommands string) {
+
+		commands, err := convertStringToCommands(listOfCommands)
+
+		if listOfCommands == "" {
+			assert.ErrorIs(t, err, ErrEmptyCommands)
+			return
+		}
+
+		if strings.Trim(listOfCommands, "ALR") == "" {
+			assert.Nil(t, err)
+			assert.Len(t, commands, utf8.RuneCountInString(listOfCommands))
+			for i, command := range commands {
+				assert.True(t, command.IsValid())
+				assert.Equal(t, string(listOfCommands[i]), string(command))
+			}
+			return
+		}
+
+		var commandErr *InvalidCommandError
+		assert.True(t, errors.As(err, &commandErr))
+		assert.Nil(t, commands)
+
+		// The reported character is the first one that is not a command, at its 1-based position in runes.
+		runes := []rune(listOfCommands)
+		assert.Equal(t, runes[commandErr.Position-1], commandErr.Character)
+		assert.Empty(t, strings.Trim(string(runes[:commandErr.Position-1]), "ALR"))
+	})
+}
+
+func FuzzTravel(f *testing.F) {
+
+	f.Fuzz(func(t *testing.T, width, height uint8, x, y int8, orientation string, listOfCommands string) {
+
+		m := NewMap(int(width%32)+1, int(height%32)+1)
+		r := NewRover(m)
+
+		rejected := 0
+		r.OnStep(func(event StepEvent) {
+			assert.True(t, m.IsValid(event.Pose.X, event.Pose.Y))
+			if !event.Accepted {
+				rejected++
+			}
+		})
+
+		result, err := r.Execute(int(x), int(y), CardinalPoint(orientation), listOfCommands)
+		if err != nil {
+			assert.Equal(t, TravelResult{}, result)
+			return
+		}
+
+		assert.True(t, m.IsValid(result.Pose.X, result.Pose.Y))
+		assert.True(t, result.Pose.Orientation.IsValid())
+		assert.Equal(t, rejected == 0, result.Valid)
+		assert.LessOrEqual(t, rejected, 1)
+
+		output, err := r.Travel(int(x), int(y), CardinalPoint(orientation), listOfCommands)
+		assert.Nil(t, err)
+		assert.Equal(t, result.Valid, strings.HasPrefix(output, "True"))
+	})
+}
+
+// travelProperty executes two lists of commands from the same random pose on an unbounded map, and checks that
+// they end with the same pose.
+func travelProperty(t *testing.T, listOfCommands, equivalent string) {
+
+	r := NewRover(NewUnboundedSparseMap())
+
+	property := func(x, y int16, turn uint8) bool {
+		orientation := clockwise[turn%4]
+
+		first, err := r.Execute(int(x), int(y), orientation, listOfCommands)
+		if err != nil {
+			return false
+		}
+
+		second, err := r.Execute(int(x), int(y), orientation, equivalent)
+		return err == nil && first.Valid == second.Valid && first.Pose == second.Pose
+	}
+
+	assert.Nil(t, quick.Check(property, nil))
+}
+
+func TestTravelProperties(t *testing.T) {
+
+	testCases := []struct {
+		name           string
+		listOfCommands string
+		equivalent     string
+	}{
+		{name: "L then R is identity", listOfCommands: "ALR", equivalent: "A"},
+		{name: "R then L is identity", listOfCommands: "ARL", equivalent: "A"},
+		{name: "Four R's are identity", listOfCommands: "ARRRR", equivalent: "A"},
+		{name: "Four L's are identity", listOfCommands: "ALLLL", equivalent: "A"},
+		{name: "Three R's are an L", listOfCommands: "RRR", equivalent: "L"},
+		{name: "Going back and forth returns to the start", listOfCommands: "AARRAARR", equivalent: "RRRR"},
+		{name: "Going around a square returns to the start", listOfCommands: "ARARARAR", equivalent: "RRRR"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			travelProperty(t, tc.listOfCommands, tc.equivalent)
+		})
+	}
+}
+
+func TestTravelProperties_Bounded(t *testing.T) {
+
+	// A travel that is valid on a map is valid on any map holding every cell it goes through, and ends the same.
+	property := func(x, y uint8, turn uint8, raw []byte) bool {
+
+		listOfCommands := commandsFromBytes(raw)
+		orientation := clockwise[turn%4]
+		small := NewMap(16, 16)
+		large := NewMap(64, 64)
+
+		onSmall, err := NewRover(small).Execute(int(x%16), int(y%16), orientation, listOfCommands)
+		if err != nil {
+			return false
+		}
+
+		onLarge, err := NewRover(large).Execute(int(x%16), int(y%16), orientation, listOfCommands)
+		if err != nil {
+			return false
+		}
+
+		return !onSmall.Valid || (onLarge.Valid && onSmall.Pose == onLarge.Pose)
+	}
+
+	assert.Nil(t, quick.Check(property, nil))
+}
+
+// commandsFromBytes maps random bytes to a list of valid commands, which is never empty.
+func commandsFromBytes(raw []byte) string {
+
+	var sb strings.Builder
+	sb.WriteString(string(Advance))
+	for _, b := range raw {
+		sb.WriteString(string([]Command{Advance, Left, Right}[b%3]))
+	}
+
+	return sb.String()
+}
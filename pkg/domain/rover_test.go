@@ -2,6 +2,7 @@ package rover
 
 import (
 	"github.com/stretchr/testify/assert"
+	"strings"
 	"testing"
 )
 
@@ -675,3 +676,286 @@ func TestNavigate(t *testing.T) {
 		})
 	}
 }
+
+func TestOnStep(t *testing.T) {
+	//Given
+	pm := NewMap(3, 3)
+	rover := NewRover(pm)
+	events := make([]StepEvent, 0)
+	rover.OnStep(func(event StepEvent) {
+		events = append(events, event)
+	})
+
+	//When
+	output, err := rover.Travel(0, 0, North, "ARAAA")
+
+	//Then
+	assert.Nil(t, err)
+	assert.Equal(t, "False, E, (2,1)", output)
+	assert.Equal(t, []StepEvent{
+		{Step: 0, Command: "", Pose: Pose{0, 0, North}, Accepted: true},
+		{Step: 1, Command: Advance, Pose: Pose{0, 1, North}, Accepted: true},
+		{Step: 2, Command: Right, Pose: Pose{0, 1, East}, Accepted: true},
+		{Step: 3, Command: Advance, Pose: Pose{1, 1, East}, Accepted: true},
+		{Step: 4, Command: Advance, Pose: Pose{2, 1, East}, Accepted: true},
+		{Step: 5, Command: Advance, Pose: Pose{2, 1, East}, Accepted: false},
+	}, events)
+	assert.Equal(t, Pose{2, 1, East}, rover.Pose())
+
+	//When
+	events = events[:0]
+	_, err = rover.Travel(0, 0, North, "X")
+
+	//Then
+	assert.NotNil(t, err)
+	assert.Len(t, events, 0, "Invalid travels must not notify any step")
+}
+
+func TestOnStep_Remove(t *testing.T) {
+	//Given
+	rover := NewRover(NewMap(3, 3))
+	first, second := 0, 0
+	removeFirst := rover.OnStep(func(StepEvent) { first++ })
+	rover.OnStep(func(StepEvent) { second++ })
+
+	//When
+	removeFirst()
+	removeFirst()
+	_, err := rover.Travel(0, 0, North, "A")
+
+	//Then
+	assert.Nil(t, err)
+	assert.Equal(t, 0, first)
+	assert.Equal(t, 2, second)
+	assert.Len(t, rover.listeners, 1)
+}
+
+func TestValidateCommands(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		unverifiedCommands string
+		asserts            func(err error)
+	}{
+		{
+			name:               "Valid commands",
+			unverifiedCommands: "ALAARA",
+			asserts: func(err error) {
+				assert.Nil(t, err)
+			},
+		},
+		{
+			name:               "Empty string",
+			unverifiedCommands: "",
+			asserts: func(err error) {
+				assert.ErrorIs(t, err, ErrEmptyCommands)
+			},
+		},
+		{
+			name:               "Every invalid character is reported",
+			unverifiedCommands: "AAXLBR9",
+			asserts: func(err error) {
+				assert.ErrorIs(t, err, ErrInvalidCommand)
+
+				problems := err.(interface{ Unwrap() []error }).Unwrap()
+				assert.Len(t, problems, 3)
+				assert.Equal(t, &InvalidCommandError{Position: 3, Character: 'X'}, problems[0])
+				assert.Equal(t, &InvalidCommandError{Position: 5, Character: 'B'}, problems[1])
+				assert.Equal(t, &InvalidCommandError{Position: 7, Character: '9'}, problems[2])
+				assert.Equal(t, "X at position 3 is not a valid command\nB at position 5 is not a valid command\n9 at position 7 is not a valid command", err.Error())
+			},
+		},
+		{
+			name:               "Lowercase commands have a suggestion",
+			unverifiedCommands: "aLr ñ",
+			asserts: func(err error) {
+				problems := err.(interface{ Unwrap() []error }).Unwrap()
+				assert.Len(t, problems, 4)
+				assert.Equal(t, &InvalidCommandError{Position: 1, Character: 'a', Suggestion: 'A'}, problems[0])
+				assert.Equal(t, &InvalidCommandError{Position: 3, Character: 'r', Suggestion: 'R'}, problems[1])
+				assert.Equal(t, &InvalidCommandError{Position: 4, Character: ' '}, problems[2])
+				assert.Equal(t, &InvalidCommandError{Position: 5, Character: 'ñ'}, problems[3])
+				assert.Equal(t, "a at position 1 is not a valid command, did you mean A?", problems[0].Error())
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+
+			// when
+			err := ValidateCommands(tt.unverifiedCommands)
+
+			//then
+			tt.asserts(err)
+		})
+	}
+}
+
+func TestConvertStringToCommands_FailFast(t *testing.T) {
+	//When
+	commands, err := convertStringToCommands("AAlXB")
+
+	//Then
+	assert.Nil(t, commands)
+	assert.Equal(t, &InvalidCommandError{Position: 3, Character: 'l', Suggestion: 'L'}, err)
+}
+
+func TestAppendTravel(t *testing.T) {
+
+	arrows := Alphabet{
+		Commands:     map[rune]Command{'↑': Advance, '↺': Left, '↻': Right},
+		Orientations: EnglishAlphabet.Orientations,
+	}
+
+	testCases := []struct {
+		name               string
+		alphabet           Alphabet
+		initialOrientation CardinalPoint
+		listOfCommands     string
+		asserts            func([]byte, error)
+	}{
+		{
+			name:               "Valid list of commands",
+			alphabet:           EnglishAlphabet,
+			initialOrientation: North,
+			listOfCommands:     "AARA",
+			asserts: func(output []byte, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, "result: True, E, (1,2)", string(output))
+			},
+		},
+		{
+			name:               "Rejected move",
+			alphabet:           SpanishAlphabet.WithLowercase(),
+			initialOrientation: West,
+			listOfCommands:     "aId",
+			asserts: func(output []byte, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, "result: Falso, O, (0,0)", string(output))
+			},
+		},
+		{
+			name:               "Characters outside ASCII",
+			alphabet:           arrows,
+			initialOrientation: East,
+			listOfCommands:     "↑↺↑↑↻",
+			asserts: func(output []byte, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, "result: True, E, (1,2)", string(output))
+			},
+		},
+		{
+			name:               "Invalid character after characters outside ASCII",
+			alphabet:           arrows,
+			initialOrientation: East,
+			listOfCommands:     "↑↺A",
+			asserts: func(output []byte, err error) {
+				assert.Equal(t, &InvalidCommandError{Position: 3, Character: 'A'}, err)
+				assert.Equal(t, "result: ", string(output))
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			rover := NewRover(NewMap(4, 5))
+			rover.UseAlphabet(tt.alphabet)
+
+			// when
+			output, err := rover.AppendTravel([]byte("result: "), 0, 0, tt.initialOrientation, tt.listOfCommands)
+
+			//then
+			tt.asserts(output, err)
+		})
+	}
+}
+
+func TestTravel_Allocations(t *testing.T) {
+
+	rover := NewRover(NewMap(10, 10))
+	commands := squareCommands(1000)
+	buffer := make([]byte, 0, 64)
+
+	testCases := []struct {
+		name     string
+		travel   func()
+		expected float64
+	}{
+		{
+			name: "Execute",
+			travel: func() {
+				_, _ = rover.Execute(0, 0, North, commands)
+			},
+			expected: 0,
+		},
+		{
+			name: "Execute with a rejected move",
+			travel: func() {
+				_, _ = rover.Execute(0, 0, South, "RRA")
+			},
+			expected: 0,
+		},
+		{
+			name: "AppendTravel",
+			travel: func() {
+				buffer, _ = rover.AppendTravel(buffer[:0], 0, 0, North, commands)
+			},
+			expected: 0,
+		},
+		{
+			name: "convertStringToCommands only allocates the commands",
+			travel: func() {
+				_, _ = convertStringToCommands(commands)
+			},
+			expected: 1,
+		},
+		{
+			name: "Travel only allocates its output",
+			travel: func() {
+				_, _ = rover.Travel(0, 0, North, commands)
+			},
+			expected: 1,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+
+			// when
+			allocations := testing.AllocsPerRun(100, tt.travel)
+
+			//then
+			assert.Equal(t, tt.expected, allocations)
+		})
+	}
+}
+
+func TestParseCommands_BufferLimit(t *testing.T) {
+	//Given
+	rover := NewRover(NewMap(10, 10))
+
+	//When
+	_, err := rover.Execute(0, 0, North, squareCommands(1000))
+
+	//Then
+	assert.Nil(t, err)
+	assert.GreaterOrEqual(t, cap(rover.commandBuffer), 1000)
+
+	//When
+	_, err = rover.Execute(0, 0, North, strings.Repeat("L", commandBufferLimit+1))
+
+	//Then
+	assert.Nil(t, err)
+	assert.Nil(t, rover.commandBuffer)
+
+	//When
+	_, err = rover.Execute(0, 0, North, "AX")
+
+	//Then
+	assert.Equal(t, &InvalidCommandError{Position: 2, Character: 'X'}, err)
+	assert.Nil(t, rover.commandBuffer)
+}
@@ -0,0 +1,101 @@
+package rover
+
+import "sync"
+
+// SafeRover wraps a Rover so that it can be shared between goroutines. Travels and single movements are
+// serialized, and Pose never sees a travel half done.
+//
+// Step listeners run while the SafeRover is locked, so they must not call back into it; the event already
+// carries the pose.
+type SafeRover struct {
+	mu    sync.RWMutex
+	rover *Rover
+}
+
+// NewSafeRover wraps the Rover, which must not be used directly afterwards.
+func NewSafeRover(rover *Rover) *SafeRover {
+
+	if rover == nil {
+		return nil
+	}
+
+	return &SafeRover{rover: rover}
+}
+
+// Travel works like Rover.Travel.
+func (s *SafeRover) Travel(initialX int, initialY int, initialOrientation CardinalPoint, listOfCommands string) (string, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.rover.Travel(initialX, initialY, initialOrientation, listOfCommands)
+}
+
+// Execute works like Rover.Execute.
+func (s *SafeRover) Execute(initialX int, initialY int, initialOrientation CardinalPoint, listOfCommands string) (TravelResult, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.rover.Execute(initialX, initialY, initialOrientation, listOfCommands)
+}
+
+// Advance works like Rover.Advance.
+func (s *SafeRover) Advance() error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.rover.Advance()
+}
+
+// TurnLeft works like Rover.TurnLeft.
+func (s *SafeRover) TurnLeft() {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rover.TurnLeft()
+}
+
+// TurnRight works like Rover.TurnRight.
+func (s *SafeRover) TurnRight() {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rover.TurnRight()
+}
+
+// Pose returns a snapshot of the Rover's pose, taken between travels.
+func (s *SafeRover) Pose() Pose {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.rover.Pose()
+}
+
+// OnStep works like Rover.OnStep. The returned function is safe to call from any goroutine.
+func (s *SafeRover) OnStep(listener StepListener) func() {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	remove := s.rover.OnStep(listener)
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		remove()
+	}
+}
+
+// Do runs the function with exclusive access to the Rover, to configure it or to run several operations
+// without other goroutines in between. The Rover must not be kept after the function returns.
+func (s *SafeRover) Do(action func(r *Rover)) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	action(s.rover)
+}
@@ -0,0 +1,152 @@
+package rover
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	stressGoroutines = 8
+	stressRounds     = 200
+)
+
+func TestNewSafeRover(t *testing.T) {
+	assert.Nil(t, NewSafeRover(nil))
+	assert.NotNil(t, NewSafeRover(NewRover(NewMap(1, 1))))
+}
+
+func TestSafeRover_ConcurrentTravels(t *testing.T) {
+
+	// given
+	safe := NewSafeRover(NewRover(NewMap(5, 5)))
+	expected := TravelResult{Valid: true, Pose: Pose{3, 3, South}}
+	expectedSteps := len("AARAAR") + 1
+
+	events := make(map[int][]StepEvent)
+	current := 0
+	safe.OnStep(func(event StepEvent) {
+		if event.Step == 0 {
+			current++
+		}
+		events[current] = append(events[current], event)
+	})
+
+	// when
+	wg := sync.WaitGroup{}
+	for g := 0; g < stressGoroutines; g++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < stressRounds; i++ {
+				result, err := safe.Execute(1, 1, North, "AARAAR")
+				assert.Nil(t, err)
+				assert.Equal(t, expected, result)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < stressRounds; i++ {
+				pose := safe.Pose()
+				assert.Contains(t, []Pose{{}, expected.Pose}, pose)
+			}
+		}()
+	}
+	wg.Wait()
+
+	//then
+	safe.Do(func(r *Rover) {
+		assert.Equal(t, stressGoroutines*stressRounds, len(events))
+		for _, travel := range events {
+			assert.Len(t, travel, expectedSteps)
+			for i, event := range travel {
+				assert.Equal(t, i, event.Step)
+			}
+		}
+	})
+}
+
+func TestSafeRover_ConcurrentMovements(t *testing.T) {
+
+	// given
+	safe := NewSafeRover(NewRover(NewUnboundedSparseMap()))
+	_, err := safe.Execute(0, 0, North, "L")
+	assert.Nil(t, err)
+	safe.TurnRight()
+
+	// when
+	wg := sync.WaitGroup{}
+	for g := 0; g < stressGoroutines; g++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < stressRounds; i++ {
+				assert.Nil(t, safe.Advance())
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < stressRounds; i++ {
+				safe.Do(func(r *Rover) {
+					r.TurnLeft()
+					r.TurnRight()
+				})
+				assert.Equal(t, 0, safe.Pose().X)
+			}
+		}()
+	}
+	wg.Wait()
+
+	//then
+	assert.Equal(t, Pose{0, stressGoroutines * stressRounds, North}, safe.Pose())
+}
+
+func TestSafeRover_ConcurrentTurns(t *testing.T) {
+
+	// given
+	safe := NewSafeRover(NewRover(NewMap(1, 1)))
+	_, err := safe.Execute(0, 0, North, "L")
+	assert.Nil(t, err)
+
+	// when
+	wg := sync.WaitGroup{}
+	for g := 0; g < stressGoroutines; g++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 4*stressRounds; i++ {
+				safe.TurnRight()
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 2*stressRounds; i++ {
+				safe.TurnLeft()
+				assert.True(t, safe.Pose().Orientation.IsValid())
+			}
+		}()
+	}
+	wg.Wait()
+
+	//then
+	assert.Equal(t, Pose{0, 0, West}, safe.Pose())
+}
+
+func TestSafeRover_OnStepRemove(t *testing.T) {
+
+	// given
+	safe := NewSafeRover(NewRover(NewMap(5, 5)))
+	count := 0
+	remove := safe.OnStep(func(event StepEvent) { count++ })
+
+	// when
+	_, err := safe.Travel(0, 0, North, "AA")
+	assert.Nil(t, err)
+	remove()
+	_, err = safe.Travel(0, 0, North, "AA")
+	assert.Nil(t, err)
+
+	//then
+	assert.Equal(t, 3, count)
+}
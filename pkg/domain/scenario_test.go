@@ -0,0 +1,189 @@
+package rover
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Regenerate the golden files with: go test ./pkg/domain -run TestScenarios -update
+var update = flag.Bool("update", false, "rewrite the golden files of the scenarios with the current outputs")
+
+// roverScenario is a Rover of a scenario. Its lists of commands are executed one after the other, each one from
+// the pose left by the previous one. Start has the orientation written with the English alphabet.
+type roverScenario struct {
+	Name      string   `json:"name"`
+	Start     Pose     `json:"start"`
+	Language  string   `json:"language,omitempty"`
+	Lowercase bool     `json:"lowercase,omitempty"`
+	Format    string   `json:"format,omitempty"`
+	Autonomy  int      `json:"autonomy,omitempty"`
+	Commands  []string `json:"commands"`
+}
+
+// scenario is a map and the rovers traveling on it, loaded from files to check that the outputs do not change.
+type scenario struct {
+	Description string          `json:"description,omitempty"`
+	Map         MapSpec         `json:"map"`
+	Rovers      []roverScenario `json:"rovers"`
+}
+
+// Run executes every Rover of the scenario in order and returns one line per list of commands, with the output
+// of Travel or the error it returned. An error is only returned when the scenario itself is not valid.
+func (s scenario) Run() (string, error) {
+
+	m, err := s.Map.Build()
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	for _, rs := range s.Rovers {
+		if err := rs.run(m, &sb); err != nil {
+			return "", fmt.Errorf("rover %v: %w", rs.Name, err)
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// run executes the lists of commands of a Rover, writing a line for each one.
+func (rs roverScenario) run(m PlanetaryMap, sb *strings.Builder) error {
+
+	language := rs.Language
+	if language == "" {
+		language = "en"
+	}
+
+	alphabet, ok := LookupAlphabet(language)
+	if !ok {
+		return fmt.Errorf("%v is not a supported language", language)
+	}
+	if rs.Lowercase {
+		alphabet = alphabet.WithLowercase()
+	}
+
+	format := rs.Format
+	if format == "" {
+		format = LegacyFormat
+	}
+
+	formatter, ok := LookupFormatter(format)
+	if !ok {
+		return fmt.Errorf("%v is not a valid format", format)
+	}
+
+	if !rs.Start.Orientation.IsValid() {
+		return &InvalidOrientationError{Orientation: rs.Start.Orientation}
+	}
+
+	r := NewRover(m)
+	r.UseAlphabet(alphabet)
+	r.UseFormatter(formatter)
+	r.EnableAutonomy(rs.Autonomy)
+
+	pose := rs.Start
+	for _, commands := range rs.Commands {
+		result, err := r.Execute(pose.X, pose.Y, pose.Orientation, commands)
+		if err != nil {
+			fmt.Fprintf(sb, "%v %v: error: %v\n", rs.Name, commands, err)
+			continue
+		}
+
+		output, err := r.output.Format(result)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(sb, "%v %v: %v\n", rs.Name, commands, output)
+		pose = result.Pose
+	}
+
+	return nil
+}
+
+// TestScenarios runs every scenario in testdata/scenarios and compares its output with the golden file next to it.
+// A scenario is added by writing NAME.json and running the test with -update to create NAME.golden.
+func TestScenarios(t *testing.T) {
+
+	files, err := filepath.Glob(filepath.Join("testdata", "scenarios", "*.json"))
+	assert.Nil(t, err)
+	assert.NotEmpty(t, files)
+
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".json")
+		t.Run(name, func(t *testing.T) {
+
+			// given
+			content, err := os.ReadFile(file)
+			assert.Nil(t, err)
+
+			loaded := scenario{}
+			decoder := json.NewDecoder(strings.NewReader(string(content)))
+			decoder.DisallowUnknownFields()
+			assert.Nil(t, decoder.Decode(&loaded))
+
+			// when
+			output, err := loaded.Run()
+			assert.Nil(t, err)
+
+			//then
+			golden := strings.TrimSuffix(file, ".json") + ".golden"
+			if *update {
+				assert.Nil(t, os.WriteFile(golden, []byte(output), 0o644))
+				return
+			}
+
+			expected, err := os.ReadFile(golden)
+			assert.Nil(t, err, "missing golden file, run the test with -update to create it")
+			assert.Equal(t, string(expected), output)
+		})
+	}
+}
+
+func TestScenario_Errors(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		scenario scenario
+		expected string
+	}{
+		{
+			name:     "Invalid map",
+			scenario: scenario{Map: MapSpec{Kind: "hexagon"}},
+			expected: "invalid map spec: hexagon is not a valid map kind",
+		},
+		{
+			name:     "Unsupported language",
+			scenario: scenario{Map: MapSpec{Kind: RectangleMapKind, Width: 2, Height: 2}, Rovers: []roverScenario{{Name: "a", Start: Pose{0, 0, North}, Language: "fr"}}},
+			expected: "rover a: fr is not a supported language",
+		},
+		{
+			name:     "Unknown format",
+			scenario: scenario{Map: MapSpec{Kind: RectangleMapKind, Width: 2, Height: 2}, Rovers: []roverScenario{{Name: "a", Start: Pose{0, 0, North}, Format: "xml"}}},
+			expected: "rover a: xml is not a valid format",
+		},
+		{
+			name:     "Invalid start orientation",
+			scenario: scenario{Map: MapSpec{Kind: RectangleMapKind, Width: 2, Height: 2}, Rovers: []roverScenario{{Name: "a", Start: Pose{0, 0, "O"}}}},
+			expected: "rover a: O is not a valid orientation",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			// when
+			_, err := tc.scenario.Run()
+
+			//then
+			assert.EqualError(t, err, tc.expected)
+		})
+	}
+}
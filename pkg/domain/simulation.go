@@ -0,0 +1,315 @@
+package rover
+
+import (
+	"container/heap"
+	"time"
+)
+
+// CommandDurations are the time each kind of command takes to be executed in a Simulation.
+type CommandDurations struct {
+	Advance time.Duration
+	Turn    time.Duration
+}
+
+// TimedStep is a StepEvent stamped with the simulated time at which the step was completed.
+type TimedStep struct {
+	Time  time.Time
+	Rover string
+	StepEvent
+}
+
+// TimedResult is the result of a list of commands executed in a Simulation, with the simulated times at which
+// its execution started and ended.
+type TimedResult struct {
+	Commands string
+	Start    time.Time
+	End      time.Time
+	TravelResult
+}
+
+type scheduledEvent struct {
+	at       time.Time
+	sequence int
+	action   func()
+}
+
+// eventQueue is a heap of events ordered by time. Events scheduled at the same time run in the order they were
+// scheduled, which keeps every Simulation deterministic.
+type eventQueue []scheduledEvent
+
+func (q eventQueue) Len() int { return len(q) }
+
+func (q eventQueue) Less(i, j int) bool {
+	if q[i].at.Equal(q[j].at) {
+		return q[i].sequence < q[j].sequence
+	}
+	return q[i].at.Before(q[j].at)
+}
+
+func (q eventQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *eventQueue) Push(x any) { *q = append(*q, x.(scheduledEvent)) }
+
+func (q *eventQueue) Pop() any {
+	old := *q
+	event := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return event
+}
+
+// Simulation is a discrete-event simulation with a virtual clock shared by every Rover added to it.
+// Nothing happens in real time: Run jumps from one scheduled event to the next.
+type Simulation struct {
+	now      time.Time
+	queue    eventQueue
+	sequence int
+	rovers   []*SimulatedRover
+	timeline []TimedStep
+}
+
+// NewSimulation creates a Simulation whose clock starts at the given time.
+func NewSimulation(start time.Time) *Simulation {
+
+	newSimulation := Simulation{
+		now: start,
+	}
+
+	return &newSimulation
+}
+
+// Now returns the current simulated time.
+func (s *Simulation) Now() time.Time {
+	return s.now
+}
+
+// Schedule runs the action when the clock reaches the given time. Times in the past are run at the current time.
+func (s *Simulation) Schedule(at time.Time, action func()) {
+
+	if at.Before(s.now) {
+		at = s.now
+	}
+
+	s.sequence++
+	heap.Push(&s.queue, scheduledEvent{at: at, sequence: s.sequence, action: action})
+}
+
+// After runs the action once the delay has passed on the simulated clock.
+func (s *Simulation) After(delay time.Duration, action func()) {
+	s.Schedule(s.now.Add(delay), action)
+}
+
+// Step moves the clock to the next event and runs it. It returns false when there are no events left.
+func (s *Simulation) Step() bool {
+
+	if len(s.queue) == 0 {
+		return false
+	}
+
+	event := heap.Pop(&s.queue).(scheduledEvent)
+	s.now = event.at
+	event.action()
+
+	return true
+}
+
+// Run executes events until there are none left.
+func (s *Simulation) Run() {
+	for s.Step() {
+	}
+}
+
+// RunUntil executes every event scheduled up to the given time and then moves the clock to it.
+func (s *Simulation) RunUntil(until time.Time) {
+
+	for len(s.queue) > 0 && !s.queue[0].at.After(until) {
+		s.Step()
+	}
+
+	if until.After(s.now) {
+		s.now = until
+	}
+}
+
+// Timeline returns every step completed by every Rover of the Simulation in the order they happened.
+func (s *Simulation) Timeline() []TimedStep {
+	return append([]TimedStep(nil), s.timeline...)
+}
+
+// SimulatedRover is a Rover taking part in a Simulation. Lists of commands submitted to it are queued and
+// executed one command at a time, each command taking its duration on the simulated clock.
+// Steps are numbered across every list of commands, the placement being step 0.
+type SimulatedRover struct {
+	name           string
+	rover          *Rover
+	simulation     *Simulation
+	durations      CommandDurations
+	pending        []string
+	busy           bool
+	step           int
+	steps          []TimedStep
+	results        []TimedResult
+	listeners      []registeredTimedListener
+	nextListenerID int
+}
+
+type registeredTimedListener struct {
+	id       int
+	listener func(step TimedStep)
+}
+
+// AddRover places the Rover at the start pose, at the current simulated time, and adds it to the Simulation.
+func (s *Simulation) AddRover(name string, rover *Rover, start Pose, durations CommandDurations) (*SimulatedRover, error) {
+
+	if err := rover.ValidatePose(start); err != nil {
+		return nil, err
+	}
+
+	newRover := SimulatedRover{
+		name:       name,
+		rover:      rover,
+		simulation: s,
+		durations:  durations,
+	}
+	s.rovers = append(s.rovers, &newRover)
+
+	rover.currentX, rover.currentY, rover.currentOrientation = start.X, start.Y, start.Orientation
+	newRover.record(Command(""), true)
+
+	return &newRover, nil
+}
+
+// Name returns the name the Rover was added with.
+func (sr *SimulatedRover) Name() string {
+	return sr.name
+}
+
+// Rover returns the Rover driven by the Simulation.
+func (sr *SimulatedRover) Rover() *Rover {
+	return sr.rover
+}
+
+// Submit queues a list of commands. It is validated right away with the Rover's Alphabet, and executed once the
+// lists submitted before it are done.
+func (sr *SimulatedRover) Submit(listOfCommands string) error {
+
+	if _, err := sr.rover.alphabet.convertStringToCommands(listOfCommands); err != nil {
+		return err
+	}
+
+	sr.pending = append(sr.pending, listOfCommands)
+	if !sr.busy {
+		sr.busy = true
+		sr.simulation.After(0, sr.startNext)
+	}
+
+	return nil
+}
+
+// OnStep registers a listener that is notified of every step completed from now on, with its simulated time.
+// The returned function removes the listener.
+func (sr *SimulatedRover) OnStep(listener func(step TimedStep)) func() {
+
+	sr.nextListenerID++
+	id := sr.nextListenerID
+	sr.listeners = append(sr.listeners, registeredTimedListener{id: id, listener: listener})
+
+	return func() {
+		for i, registered := range sr.listeners {
+			if registered.id == id {
+				sr.listeners = append(sr.listeners[:i:i], sr.listeners[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Steps returns the steps completed by the Rover with the time each one was completed.
+func (sr *SimulatedRover) Steps() []TimedStep {
+	return append([]TimedStep(nil), sr.steps...)
+}
+
+// Results returns the result of every list of commands already executed.
+func (sr *SimulatedRover) Results() []TimedResult {
+	return append([]TimedResult(nil), sr.results...)
+}
+
+// startNext starts executing the oldest pending list of commands.
+func (sr *SimulatedRover) startNext() {
+
+	if len(sr.pending) == 0 {
+		sr.busy = false
+		return
+	}
+
+	listOfCommands := sr.pending[0]
+	sr.pending = sr.pending[1:]
+
+	commands, _ := sr.rover.alphabet.convertStringToCommands(listOfCommands)
+	result := TimedResult{Commands: listOfCommands, Start: sr.simulation.Now()}
+	sr.execute(commands, result)
+}
+
+// execute schedules the completion of the first command, which in turn schedules the following one.
+// A rejected Advance still takes its duration, and ends the list of commands like it does in Travel.
+func (sr *SimulatedRover) execute(commands []Command, result TimedResult) {
+
+	if len(commands) == 0 {
+		sr.finish(result, true)
+		return
+	}
+
+	command := commands[0]
+	duration := sr.durations.Turn
+	if command == Advance {
+		duration = sr.durations.Advance
+	}
+
+	sr.simulation.After(duration, func() {
+		switch command {
+		case Left:
+			sr.rover.TurnLeft()
+		case Right:
+			sr.rover.TurnRight()
+		case Advance:
+			if err := sr.rover.Advance(); err != nil {
+				sr.record(command, false)
+				sr.finish(result, false)
+				return
+			}
+		}
+
+		sr.record(command, true)
+		sr.execute(commands[1:], result)
+	})
+}
+
+// finish stores the result of the current list of commands and moves on to the next one.
+func (sr *SimulatedRover) finish(result TimedResult, valid bool) {
+
+	result.End = sr.simulation.Now()
+	result.TravelResult = TravelResult{Valid: valid, Pose: sr.rover.Pose()}
+	sr.results = append(sr.results, result)
+
+	sr.startNext()
+}
+
+// record stamps the step with the current simulated time and notifies the Rover's listeners.
+func (sr *SimulatedRover) record(command Command, accepted bool) {
+
+	if command != "" {
+		sr.step++
+	}
+
+	sr.rover.notify(sr.step, command, accepted)
+	step := TimedStep{
+		Time:      sr.simulation.Now(),
+		Rover:     sr.name,
+		StepEvent: StepEvent{Step: sr.step, Command: command, Pose: sr.rover.Pose(), Accepted: accepted},
+	}
+
+	sr.steps = append(sr.steps, step)
+	sr.simulation.timeline = append(sr.simulation.timeline, step)
+	for _, registered := range sr.listeners {
+		registered.listener(step)
+	}
+}
@@ -0,0 +1,131 @@
+package rover
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var simulationStart = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestSimulation_Schedule(t *testing.T) {
+	//Given
+	sim := NewSimulation(simulationStart)
+	order := make([]string, 0)
+
+	//When
+	sim.After(2*time.Second, func() { order = append(order, "second") })
+	sim.After(time.Second, func() {
+		order = append(order, "first")
+		sim.After(time.Second, func() { order = append(order, "scheduled later at the same time") })
+	})
+	sim.After(2*time.Second, func() { order = append(order, "second again") })
+	sim.Schedule(simulationStart.Add(-time.Hour), func() { order = append(order, "past") })
+
+	//Then
+	assert.Equal(t, simulationStart, sim.Now())
+
+	//When
+	sim.RunUntil(simulationStart.Add(1500 * time.Millisecond))
+
+	//Then
+	assert.Equal(t, []string{"past", "first"}, order)
+	assert.Equal(t, simulationStart.Add(1500*time.Millisecond), sim.Now())
+
+	//When
+	sim.Run()
+
+	//Then
+	assert.Equal(t, []string{"past", "first", "second", "second again", "scheduled later at the same time"}, order)
+	assert.Equal(t, simulationStart.Add(2*time.Second), sim.Now())
+	assert.False(t, sim.Step())
+}
+
+func TestSimulation_AddRover(t *testing.T) {
+
+	sim := NewSimulation(simulationStart)
+	durations := CommandDurations{Advance: time.Second, Turn: time.Second}
+
+	_, err := sim.AddRover("nil", nil, Pose{0, 0, North}, durations)
+	assert.ErrorIs(t, err, ErrRoverNotInitialized)
+
+	_, err = sim.AddRover("out", NewRover(NewMap(2, 2)), Pose{2, 0, North}, durations)
+	assert.ErrorIs(t, err, ErrInvalidCoordinate)
+
+	_, err = sim.AddRover("lost", NewRover(NewMap(2, 2)), Pose{0, 0, "X"}, durations)
+	assert.ErrorIs(t, err, ErrInvalidOrientation)
+
+	sr, err := sim.AddRover("curiosity", NewRover(NewMap(2, 2)), Pose{1, 1, South}, durations)
+	assert.Nil(t, err)
+	assert.Equal(t, "curiosity", sr.Name())
+	assert.Equal(t, Pose{1, 1, South}, sr.Rover().Pose())
+	assert.Equal(t, []TimedStep{{Time: simulationStart, Rover: "curiosity", StepEvent: StepEvent{Step: 0, Pose: Pose{1, 1, South}, Accepted: true}}}, sr.Steps())
+}
+
+func TestSimulatedRover_Submit(t *testing.T) {
+	//Given
+	sim := NewSimulation(simulationStart)
+	pm := NewMap(5, 5)
+	fast, _ := sim.AddRover("fast", NewRover(pm), Pose{0, 0, North}, CommandDurations{Advance: 10 * time.Second, Turn: 2 * time.Second})
+	slow, _ := sim.AddRover("slow", NewRover(pm), Pose{4, 4, South}, CommandDurations{Advance: 30 * time.Second, Turn: 5 * time.Second})
+	listened := 0
+	fast.Rover().OnStep(func(StepEvent) { listened++ })
+
+	//When
+	assert.Nil(t, fast.Submit("ARA"))
+	assert.Nil(t, fast.Submit("LAAAAA"))
+	assert.Nil(t, fast.Submit("A"))
+	assert.Nil(t, slow.Submit("AL"))
+	assert.ErrorIs(t, slow.Submit("AXA"), ErrInvalidCommand)
+	sim.Run()
+
+	//Then
+	at := func(seconds int) time.Time { return simulationStart.Add(time.Duration(seconds) * time.Second) }
+
+	assert.Equal(t, []TimedResult{
+		{Commands: "ARA", Start: at(0), End: at(22), TravelResult: TravelResult{Valid: true, Pose: Pose{1, 1, East}}},
+		{Commands: "LAAAAA", Start: at(22), End: at(64), TravelResult: TravelResult{Valid: false, Pose: Pose{1, 4, North}}},
+		{Commands: "A", Start: at(64), End: at(74), TravelResult: TravelResult{Valid: false, Pose: Pose{1, 4, North}}},
+	}, fast.Results())
+
+	assert.Equal(t, []TimedResult{
+		{Commands: "AL", Start: at(0), End: at(35), TravelResult: TravelResult{Valid: true, Pose: Pose{4, 3, East}}},
+	}, slow.Results())
+
+	steps := fast.Steps()
+	assert.Len(t, steps, 10)
+	assert.Equal(t, TimedStep{Time: at(10), Rover: "fast", StepEvent: StepEvent{Step: 1, Command: Advance, Pose: Pose{0, 1, North}, Accepted: true}}, steps[1])
+	assert.Equal(t, TimedStep{Time: at(64), Rover: "fast", StepEvent: StepEvent{Step: 8, Command: Advance, Pose: Pose{1, 4, North}, Accepted: false}}, steps[8])
+	assert.Equal(t, TimedStep{Time: at(74), Rover: "fast", StepEvent: StepEvent{Step: 9, Command: Advance, Pose: Pose{1, 4, North}, Accepted: false}}, steps[9])
+	assert.Equal(t, 9, listened, "Placement happened before the listener was registered")
+
+	timeline := sim.Timeline()
+	assert.Len(t, timeline, 10+3)
+	for i := 1; i < len(timeline); i++ {
+		assert.False(t, timeline[i].Time.Before(timeline[i-1].Time), "Timeline must be ordered by time")
+	}
+	assert.Equal(t, "slow", timeline[6].Rover)
+	assert.Equal(t, at(30), timeline[6].Time)
+}
+
+func TestSimulatedRover_OnStep(t *testing.T) {
+	//Given
+	sim := NewSimulation(simulationStart)
+	sr, _ := sim.AddRover("curiosity", NewRover(NewMap(5, 5)), Pose{0, 0, North}, CommandDurations{Advance: time.Second, Turn: time.Second})
+	var removed, kept []TimedStep
+	remove := sr.OnStep(func(step TimedStep) { removed = append(removed, step) })
+	sr.OnStep(func(step TimedStep) { kept = append(kept, step) })
+
+	//When
+	assert.Nil(t, sr.Submit("AR"))
+	sim.Run()
+	remove()
+	assert.Nil(t, sr.Submit("A"))
+	sim.Run()
+
+	//Then
+	assert.Len(t, removed, 2)
+	assert.Len(t, kept, 3)
+	assert.Equal(t, Pose{1, 1, East}, kept[2].Pose)
+	assert.Equal(t, simulationStart.Add(3*time.Second), kept[2].Time)
+}
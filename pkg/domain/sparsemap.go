@@ -0,0 +1,141 @@
+package rover
+
+// chunkShift is the log2 of the side of a chunk, so every chunk covers 64x64 cells.
+const chunkShift = 6
+
+const (
+	chunkSide  = 1 << chunkShift
+	chunkMask  = chunkSide - 1
+	chunkWords = chunkSide * chunkSide / 64
+)
+
+type chunkKey struct {
+	x int
+	y int
+}
+
+// chunk is a fixed size bitset of obstacles. Only chunks with at least one obstacle are kept in memory.
+type chunk struct {
+	bits      [chunkWords]uint64
+	obstacles int
+}
+
+// SparseMap is a PlanetaryMap meant for huge or unbounded coordinate spaces. Obstacles are stored in hashed
+// chunks, so the memory used is proportional to the populated area instead of the size of the map.
+type SparseMap struct {
+	width     int
+	height    int
+	bounded   bool
+	chunks    map[chunkKey]*chunk
+	obstacles int
+}
+
+// NewSparseMap creates a sparse map whose valid cells go from (0,0) to (width-1,height-1).
+func NewSparseMap(width, height int) *SparseMap {
+
+	newMap := SparseMap{
+		width:   width,
+		height:  height,
+		bounded: true,
+		chunks:  make(map[chunkKey]*chunk),
+	}
+
+	return &newMap
+}
+
+// NewUnboundedSparseMap creates a sparse map without edges, negative coordinates included.
+func NewUnboundedSparseMap() *SparseMap {
+
+	newMap := SparseMap{
+		chunks: make(map[chunkKey]*chunk),
+	}
+
+	return &newMap
+}
+
+// IsValid validates a pair of x and y coordinates checking the map's bounds, if any, and its obstacles.
+func (m *SparseMap) IsValid(xCoordinate, yCoordinate int) bool {
+
+	if m.bounded && (xCoordinate < 0 || xCoordinate >= m.width || yCoordinate < 0 || yCoordinate >= m.height) {
+		return false
+	}
+
+	return !m.IsObstacle(xCoordinate, yCoordinate)
+}
+
+// Bounds returns the rectangle covered by the map. Unbounded maps report false.
+func (m *SparseMap) Bounds() (Rectangle, bool) {
+	return Rectangle{X: 0, Y: 0, Width: m.width, Height: m.height}, m.bounded
+}
+
+// IsObstacle checks if there is an obstacle at x and y coordinates.
+func (m *SparseMap) IsObstacle(xCoordinate, yCoordinate int) bool {
+
+	c, ok := m.chunks[chunkKeyOf(xCoordinate, yCoordinate)]
+	if !ok {
+		return false
+	}
+
+	word, bit := bitOf(xCoordinate, yCoordinate)
+	return c.bits[word]&bit != 0
+}
+
+// SetObstacle places an obstacle at x and y coordinates.
+func (m *SparseMap) SetObstacle(xCoordinate, yCoordinate int) {
+
+	key := chunkKeyOf(xCoordinate, yCoordinate)
+	c, ok := m.chunks[key]
+	if !ok {
+		c = &chunk{}
+		m.chunks[key] = c
+	}
+
+	word, bit := bitOf(xCoordinate, yCoordinate)
+	if c.bits[word]&bit != 0 {
+		return
+	}
+
+	c.bits[word] |= bit
+	c.obstacles++
+	m.obstacles++
+}
+
+// RemoveObstacle clears the obstacle at x and y coordinates, releasing its chunk when it becomes empty.
+func (m *SparseMap) RemoveObstacle(xCoordinate, yCoordinate int) {
+
+	key := chunkKeyOf(xCoordinate, yCoordinate)
+	c, ok := m.chunks[key]
+	if !ok {
+		return
+	}
+
+	word, bit := bitOf(xCoordinate, yCoordinate)
+	if c.bits[word]&bit == 0 {
+		return
+	}
+
+	c.bits[word] &^= bit
+	c.obstacles--
+	m.obstacles--
+
+	if c.obstacles == 0 {
+		delete(m.chunks, key)
+	}
+}
+
+// ObstacleCount returns the number of obstacles placed on the map.
+func (m *SparseMap) ObstacleCount() int {
+	return m.obstacles
+}
+
+// chunkKeyOf returns the key of the chunk holding the cell. Arithmetic shifts round towards negative infinity,
+// so negative coordinates land on their own chunks instead of sharing chunk 0.
+func chunkKeyOf(xCoordinate, yCoordinate int) chunkKey {
+	return chunkKey{x: xCoordinate >> chunkShift, y: yCoordinate >> chunkShift}
+}
+
+// bitOf returns the word index and the bit mask of the cell inside its chunk.
+func bitOf(xCoordinate, yCoordinate int) (int, uint64) {
+	index := (yCoordinate&chunkMask)*chunkSide + xCoordinate&chunkMask
+	return index / 64, 1 << (index % 64)
+}
@@ -0,0 +1,173 @@
+package rover
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+func TestSparseMapConstructor(t *testing.T) {
+
+	pm := NewSparseMap(1000000, 1000000)
+
+	assert.NotNil(t, pm, "The new sparse map method returned nil")
+	assert.True(t, pm.bounded)
+	assert.Equal(t, 1000000, pm.width)
+	assert.Equal(t, 1000000, pm.height)
+	assert.Equal(t, 0, pm.ObstacleCount())
+
+	pm = NewUnboundedSparseMap()
+
+	assert.NotNil(t, pm, "The new unbounded sparse map method returned nil")
+	assert.False(t, pm.bounded)
+}
+
+func TestSparseMap_IsValid(t *testing.T) {
+
+	bounded := NewSparseMap(1000000, 1000000)
+	bounded.SetObstacle(500000, 500000)
+
+	unbounded := NewUnboundedSparseMap()
+	unbounded.SetObstacle(-1, -1)
+	unbounded.SetObstacle(-64, 63)
+
+	testCases := []struct {
+		name           string
+		planetaryMap   *SparseMap
+		xCoordinate    int
+		yCoordinate    int
+		expectedResult bool
+	}{
+		{name: "Should be In when bottom left corner", planetaryMap: bounded, xCoordinate: 0, yCoordinate: 0, expectedResult: true},
+		{name: "Should be In when top right corner", planetaryMap: bounded, xCoordinate: 999999, yCoordinate: 999999, expectedResult: true},
+		{name: "Should be Out when right of top right corner", planetaryMap: bounded, xCoordinate: 1000000, yCoordinate: 999999, expectedResult: false},
+		{name: "Should be Out when negative on bounded map", planetaryMap: bounded, xCoordinate: -1, yCoordinate: 0, expectedResult: false},
+		{name: "Should be Out when on an obstacle", planetaryMap: bounded, xCoordinate: 500000, yCoordinate: 500000, expectedResult: false},
+		{name: "Should be In when next to an obstacle", planetaryMap: bounded, xCoordinate: 500001, yCoordinate: 500000, expectedResult: true},
+		{name: "Should be In when far away on unbounded map", planetaryMap: unbounded, xCoordinate: -1 << 40, yCoordinate: 1 << 40, expectedResult: true},
+		{name: "Should be Out when on a negative obstacle", planetaryMap: unbounded, xCoordinate: -1, yCoordinate: -1, expectedResult: false},
+		{name: "Should be In when mirrored cell of a negative obstacle", planetaryMap: unbounded, xCoordinate: 63, yCoordinate: 63, expectedResult: true},
+		{name: "Should be Out when on obstacle at chunk edge", planetaryMap: unbounded, xCoordinate: -64, yCoordinate: 63, expectedResult: false},
+		{name: "Should be In when next chunk of obstacle at chunk edge", planetaryMap: unbounded, xCoordinate: -65, yCoordinate: 63, expectedResult: true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+
+			// given
+			pm := tt.planetaryMap
+
+			// when
+			result := pm.IsValid(tt.xCoordinate, tt.yCoordinate)
+
+			//then
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
+
+func TestSparseMap_Obstacles(t *testing.T) {
+	//Given
+	pm := NewUnboundedSparseMap()
+
+	//When
+	pm.SetObstacle(3, 4)
+	pm.SetObstacle(3, 4)
+	pm.SetObstacle(-3, -4)
+
+	//Then
+	assert.True(t, pm.IsObstacle(3, 4))
+	assert.True(t, pm.IsObstacle(-3, -4))
+	assert.Equal(t, 2, pm.ObstacleCount())
+	assert.Len(t, pm.chunks, 2)
+
+	//When
+	pm.RemoveObstacle(3, 4)
+	pm.RemoveObstacle(3, 4)
+	pm.RemoveObstacle(100, 100)
+
+	//Then
+	assert.False(t, pm.IsObstacle(3, 4))
+	assert.Equal(t, 1, pm.ObstacleCount())
+	assert.Len(t, pm.chunks, 1, "Empty chunks should be released")
+}
+
+func TestSparseMap_Travel(t *testing.T) {
+	//Given
+	pm := NewUnboundedSparseMap()
+	pm.SetObstacle(-2, -5)
+	rover := NewRover(pm)
+
+	//When
+	output, err := rover.Travel(-2, 0, South, "AAAAA")
+
+	//Then
+	assert.Nil(t, err)
+	assert.Equal(t, "False, S, (-2,-4)", output)
+}
+
+// sparseMapSides are the sizes used to show that IsValid and Advance do not get slower as the map grows.
+var sparseMapSides = []int{1000, 10000, 100000, 1000000}
+
+// newPopulatedSparseMap returns a map of the given side with a fixed number of random obstacles,
+// keeping the 2x2 square at the centre of the map free.
+func newPopulatedSparseMap(side int) *SparseMap {
+
+	random := rand.New(rand.NewSource(1))
+	pm := NewSparseMap(side, side)
+	centre := side / 2
+
+	for i := 0; i < 10000; i++ {
+		x, y := random.Intn(side), random.Intn(side)
+		if (x == centre || x == centre+1) && (y == centre || y == centre+1) {
+			continue
+		}
+		pm.SetObstacle(x, y)
+	}
+
+	return pm
+}
+
+func BenchmarkSparseMap_IsValid(b *testing.B) {
+
+	for _, side := range sparseMapSides {
+		b.Run(fmt.Sprintf("side=%d", side), func(b *testing.B) {
+			pm := newPopulatedSparseMap(side)
+			random := rand.New(rand.NewSource(2))
+			xs := make([]int, 1024)
+			ys := make([]int, 1024)
+			for i := range xs {
+				xs[i], ys[i] = random.Intn(side), random.Intn(side)
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				pm.IsValid(xs[i&1023], ys[i&1023])
+			}
+		})
+	}
+}
+
+func BenchmarkSparseMap_Advance(b *testing.B) {
+
+	for _, side := range sparseMapSides {
+		b.Run(fmt.Sprintf("side=%d", side), func(b *testing.B) {
+			rover := NewRover(newPopulatedSparseMap(side))
+			rover.currentX = side / 2
+			rover.currentY = side / 2
+			rover.currentOrientation = North
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				// Advancing and turning right keeps the rover going round the free square at the centre.
+				if err := rover.Advance(); err != nil {
+					b.Fatal(err)
+				}
+				rover.TurnRight()
+			}
+		})
+	}
+}
@@ -0,0 +1,307 @@
+// Package feed streams the steps of running rovers to browsers over WebSocket.
+package feed
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gorilla/websocket"
+
+	domain "github.com/undernet00/MarsRoverGo/pkg/domain"
+)
+
+// Kinds of Update sent to the clients.
+const (
+	PoseUpdate     = "pose"
+	RejectedUpdate = "rejected"
+	ResultUpdate   = "result"
+	ErrorUpdate    = "error"
+)
+
+// Update is a message sent to the clients. Pose and rejected updates are sent to every client for every step of
+// every rover. Result and error updates only go to the client that submitted the commands.
+// Commands and orientations are written with the letters of the rover's Alphabet, the ones its operators type.
+// Dropped tells how many updates the client missed right before this one because it was too slow.
+type Update struct {
+	Type    string  `json:"type"`
+	Rover   string  `json:"rover"`
+	Step    int     `json:"step,omitempty"`
+	Command string  `json:"command,omitempty"`
+	Pose    *Pose   `json:"pose,omitempty"`
+	Detour  bool    `json:"detour,omitempty"`
+	Result  *Result `json:"result,omitempty"`
+	Error   string  `json:"error,omitempty"`
+	Dropped int64   `json:"dropped,omitempty"`
+}
+
+// Pose is the position of a rover with the letter of its orientation.
+type Pose struct {
+	X           int    `json:"x"`
+	Y           int    `json:"y"`
+	Orientation string `json:"orientation"`
+}
+
+// Result is the outcome of a travel, with the commands of its detours written with the rover's letters.
+type Result struct {
+	Valid    bool            `json:"valid"`
+	Pose     Pose            `json:"pose"`
+	Geofence string          `json:"geofence,omitempty"`
+	Detours  []domain.Detour `json:"detours,omitempty"`
+}
+
+// Submission is a message received from a client, asking a rover to travel from its current pose.
+type Submission struct {
+	Rover    string `json:"rover"`
+	Commands string `json:"commands"`
+}
+
+// ErrRoverExists is returned by AddRover when the name is already taken.
+var ErrRoverExists = errors.New("rover already exists")
+
+// Hub keeps the rovers fed to the clients and the connected clients.
+type Hub struct {
+	queueSize int
+	upgrader  websocket.Upgrader
+
+	mu      sync.RWMutex
+	rovers  map[string]*feedRover
+	clients map[*client]struct{}
+}
+
+// feedRover is a Rover with the pose it was left at. Travels on the same Rover are serialized by mu.
+type feedRover struct {
+	mu       sync.Mutex
+	rover    *domain.Rover
+	alphabet domain.Alphabet
+	pose     domain.Pose
+}
+
+// NewHub creates a Hub that queues up to queueSize updates for every client. When a client falls further behind,
+// its oldest updates are dropped so that rovers never wait for it.
+func NewHub(queueSize int) *Hub {
+
+	if queueSize < 1 {
+		queueSize = 1
+	}
+
+	return &Hub{
+		queueSize: queueSize,
+		rovers:    make(map[string]*feedRover),
+		clients:   make(map[*client]struct{}),
+	}
+}
+
+// AddRover feeds the steps of a Rover to the clients and lets them submit commands to it from the start pose,
+// which must be valid for the Rover. Updates use the Alphabet the Rover has when it is added.
+// The Rover may also travel on its own, its steps are sent all the same.
+func (h *Hub) AddRover(name string, rover *domain.Rover, start domain.Pose) error {
+
+	if err := rover.ValidatePose(start); err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.rovers[name]; ok {
+		return fmt.Errorf("%w: %v", ErrRoverExists, name)
+	}
+
+	alphabet := rover.Alphabet()
+	h.rovers[name] = &feedRover{rover: rover, alphabet: alphabet, pose: start}
+	rover.OnStep(func(event domain.StepEvent) {
+		h.broadcast(stepUpdate(name, event, alphabet))
+	})
+
+	return nil
+}
+
+// ServeHTTP upgrades the request to a WebSocket connection and serves the client until it goes away.
+func (h *Hub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	// The client is added before the handshake completes so that it gets every step once it is connected.
+	c := newClient(h.queueSize)
+	h.mu.Lock()
+	h.clients[c] = struct{}{}
+	h.mu.Unlock()
+
+	defer func() {
+		h.mu.Lock()
+		delete(h.clients, c)
+		h.mu.Unlock()
+		c.close()
+	}()
+
+	connection, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	go c.write(connection)
+	h.read(c, connection)
+}
+
+// read executes the submissions of a client until its connection is closed.
+func (h *Hub) read(c *client, connection *websocket.Conn) {
+
+	for {
+		_, message, err := connection.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		submission := Submission{}
+		if err := json.Unmarshal(message, &submission); err != nil {
+			c.enqueue(Update{Type: ErrorUpdate, Error: fmt.Sprintf("%v is not a valid submission", string(message))})
+			continue
+		}
+
+		c.enqueue(h.submit(submission))
+	}
+}
+
+// submit makes a rover travel and returns the update for the client that asked for it.
+func (h *Hub) submit(submission Submission) Update {
+
+	h.mu.RLock()
+	fr, ok := h.rovers[submission.Rover]
+	h.mu.RUnlock()
+
+	if !ok {
+		return Update{Type: ErrorUpdate, Rover: submission.Rover, Error: fmt.Sprintf("rover %v does not exist", submission.Rover)}
+	}
+
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+
+	result, err := fr.rover.Execute(fr.pose.X, fr.pose.Y, fr.pose.Orientation, submission.Commands)
+	if err != nil {
+		return Update{Type: ErrorUpdate, Rover: submission.Rover, Error: err.Error()}
+	}
+	fr.pose = result.Pose
+
+	return Update{Type: ResultUpdate, Rover: submission.Rover, Result: newResult(result, fr.alphabet)}
+}
+
+// broadcast queues an update for every client without waiting for any of them.
+func (h *Hub) broadcast(update Update) {
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for c := range h.clients {
+		c.enqueue(update)
+	}
+}
+
+func stepUpdate(name string, event domain.StepEvent, alphabet domain.Alphabet) Update {
+
+	pose := newPose(event.Pose, alphabet)
+	update := Update{Type: PoseUpdate, Rover: name, Step: event.Step, Pose: &pose, Detour: event.Detour}
+	if event.Command != "" {
+		update.Command = alphabet.CommandLetter(event.Command)
+	}
+	if !event.Accepted {
+		update.Type = RejectedUpdate
+	}
+
+	return update
+}
+
+func newPose(p domain.Pose, alphabet domain.Alphabet) Pose {
+	return Pose{X: p.X, Y: p.Y, Orientation: alphabet.Letter(p.Orientation)}
+}
+
+func newResult(r domain.TravelResult, alphabet domain.Alphabet) *Result {
+
+	result := Result{Valid: r.Valid, Pose: newPose(r.Pose, alphabet), Geofence: r.Geofence}
+	for _, detour := range r.Detours {
+		detour.Commands = alphabet.LocalizeCommands(detour.Commands)
+		result.Detours = append(result.Detours, detour)
+	}
+
+	return &result
+}
+
+// client is a connected browser. Updates wait in queue until they are written to the connection.
+type client struct {
+	queueMu sync.Mutex
+	queue   chan Update
+	dropped atomic.Int64
+	done    chan struct{}
+	closed  bool
+}
+
+func newClient(queueSize int) *client {
+	return &client{queue: make(chan Update, queueSize), done: make(chan struct{})}
+}
+
+// enqueue adds an update to the queue, dropping the oldest one when it is full. It never blocks.
+func (c *client) enqueue(update Update) {
+
+	c.queueMu.Lock()
+	defer c.queueMu.Unlock()
+
+	if c.closed {
+		return
+	}
+
+	for {
+		select {
+		case c.queue <- update:
+			return
+		default:
+		}
+
+		select {
+		case <-c.queue:
+			c.dropped.Add(1)
+		default:
+		}
+	}
+}
+
+// next returns the following update with the number of updates dropped before it.
+func (c *client) next() (Update, bool) {
+
+	select {
+	case update := <-c.queue:
+		update.Dropped = c.dropped.Swap(0)
+		return update, true
+	case <-c.done:
+		return Update{}, false
+	}
+}
+
+func (c *client) close() {
+
+	c.queueMu.Lock()
+	defer c.queueMu.Unlock()
+
+	if !c.closed {
+		c.closed = true
+		close(c.done)
+	}
+}
+
+// write sends the queued updates to the connection until the client is closed.
+func (c *client) write(connection *websocket.Conn) {
+
+	defer connection.Close()
+
+	for {
+		update, ok := c.next()
+		if !ok {
+			return
+		}
+
+		if err := connection.WriteJSON(update); err != nil {
+			c.close()
+			return
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package feed
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+
+	domain "github.com/undernet00/MarsRoverGo/pkg/domain"
+)
+
+func dial(t *testing.T, server *httptest.Server) *websocket.Conn {
+
+	connection, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	assert.Nil(t, err)
+	t.Cleanup(func() { connection.Close() })
+	connection.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	return connection
+}
+
+func readUpdates(t *testing.T, connection *websocket.Conn, count int) []Update {
+
+	updates := make([]Update, 0, count)
+	for i := 0; i < count; i++ {
+		update := Update{}
+		assert.Nil(t, connection.ReadJSON(&update))
+		updates = append(updates, update)
+	}
+
+	return updates
+}
+
+func TestHub(t *testing.T) {
+
+	// given
+	hub := NewHub(16)
+	assert.Nil(t, hub.AddRover("curiosity", domain.NewRover(domain.NewMap(3, 3)), domain.Pose{X: 0, Y: 1, Orientation: domain.North}))
+	server := httptest.NewServer(hub)
+	defer server.Close()
+
+	operator := dial(t, server)
+	watcher := dial(t, server)
+
+	// when
+	assert.Nil(t, operator.WriteJSON(Submission{Rover: "curiosity", Commands: "ARAA"}))
+
+	//then
+	steps := []Update{
+		{Type: PoseUpdate, Rover: "curiosity", Pose: &Pose{X: 0, Y: 1, Orientation: "N"}},
+		{Type: PoseUpdate, Rover: "curiosity", Step: 1, Command: "A", Pose: &Pose{X: 0, Y: 2, Orientation: "N"}},
+		{Type: PoseUpdate, Rover: "curiosity", Step: 2, Command: "R", Pose: &Pose{X: 0, Y: 2, Orientation: "E"}},
+		{Type: PoseUpdate, Rover: "curiosity", Step: 3, Command: "A", Pose: &Pose{X: 1, Y: 2, Orientation: "E"}},
+		{Type: PoseUpdate, Rover: "curiosity", Step: 4, Command: "A", Pose: &Pose{X: 2, Y: 2, Orientation: "E"}},
+	}
+	result := Update{Type: ResultUpdate, Rover: "curiosity", Result: &Result{Valid: true, Pose: Pose{X: 2, Y: 2, Orientation: "E"}}}
+
+	assert.Equal(t, append(steps, result), readUpdates(t, operator, len(steps)+1))
+	assert.Equal(t, steps, readUpdates(t, watcher, len(steps)))
+
+	// when
+	assert.Nil(t, watcher.WriteJSON(Submission{Rover: "curiosity", Commands: "A"}))
+
+	//then
+	updates := readUpdates(t, operator, 2)
+	assert.Equal(t, Update{Type: RejectedUpdate, Rover: "curiosity", Step: 1, Command: "A", Pose: &Pose{X: 2, Y: 2, Orientation: "E"}}, updates[1])
+	updates = readUpdates(t, watcher, 3)
+	assert.Equal(t, ResultUpdate, updates[2].Type)
+	assert.False(t, updates[2].Result.Valid)
+}
+
+func TestHub_SpanishRover(t *testing.T) {
+
+	// given
+	rover := domain.NewRover(domain.NewMap(3, 3))
+	rover.UseAlphabet(domain.SpanishAlphabet)
+	hub := NewHub(16)
+	assert.Nil(t, hub.AddRover("curiosity", rover, domain.Pose{X: 1, Y: 1, Orientation: domain.North}))
+	server := httptest.NewServer(hub)
+	defer server.Close()
+
+	operator := dial(t, server)
+
+	// when
+	assert.Nil(t, operator.WriteJSON(Submission{Rover: "curiosity", Commands: "I"}))
+	turned := readUpdates(t, operator, 3)
+	assert.Nil(t, operator.WriteJSON(Submission{Rover: "curiosity", Commands: "A"}))
+	advanced := readUpdates(t, operator, 3)
+
+	//then
+	assert.Equal(t, Update{Type: PoseUpdate, Rover: "curiosity", Step: 1, Command: "I", Pose: &Pose{X: 1, Y: 1, Orientation: "O"}}, turned[1])
+	assert.Equal(t, &Result{Valid: true, Pose: Pose{X: 1, Y: 1, Orientation: "O"}}, turned[2].Result)
+	assert.Equal(t, ResultUpdate, advanced[2].Type)
+	assert.Equal(t, &Result{Valid: true, Pose: Pose{X: 0, Y: 1, Orientation: "O"}}, advanced[2].Result)
+}
+
+func TestHub_Errors(t *testing.T) {
+
+	hub := NewHub(16)
+	assert.Nil(t, hub.AddRover("curiosity", domain.NewRover(domain.NewMap(3, 3)), domain.Pose{Orientation: domain.North}))
+	server := httptest.NewServer(hub)
+	defer server.Close()
+	connection := dial(t, server)
+
+	testCases := []struct {
+		name     string
+		message  string
+		expected Update
+	}{
+		{
+			name:     "Unknown rover",
+			message:  `{"rover":"opportunity","commands":"A"}`,
+			expected: Update{Type: ErrorUpdate, Rover: "opportunity", Error: "rover opportunity does not exist"},
+		},
+		{
+			name:     "Invalid commands",
+			message:  `{"rover":"curiosity","commands":"AX"}`,
+			expected: Update{Type: ErrorUpdate, Rover: "curiosity", Error: "X at position 2 is not a valid command"},
+		},
+		{
+			name:     "Invalid submission",
+			message:  `AAL`,
+			expected: Update{Type: ErrorUpdate, Error: "AAL is not a valid submission"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			// when
+			assert.Nil(t, connection.WriteMessage(websocket.TextMessage, []byte(tc.message)))
+
+			//then
+			assert.Equal(t, []Update{tc.expected}, readUpdates(t, connection, 1))
+		})
+	}
+}
+
+func TestHub_AddRover(t *testing.T) {
+
+	// given
+	hub := NewHub(1)
+	rover := domain.NewRover(domain.NewMap(3, 3))
+
+	// when
+	first := hub.AddRover("curiosity", rover, domain.Pose{Orientation: domain.North})
+	second := hub.AddRover("curiosity", rover, domain.Pose{Orientation: domain.North})
+
+	//then
+	assert.Nil(t, first)
+	assert.ErrorIs(t, second, ErrRoverExists)
+	assert.ErrorIs(t, hub.AddRover("spirit", nil, domain.Pose{}), domain.ErrRoverNotInitialized)
+	assert.ErrorIs(t, hub.AddRover("spirit", rover, domain.Pose{X: 3, Orientation: domain.North}), domain.ErrInvalidCoordinate)
+	assert.ErrorIs(t, hub.AddRover("spirit", rover, domain.Pose{Orientation: "X"}), domain.ErrInvalidOrientation)
+	assert.ErrorIs(t, hub.AddRover("spirit", rover, domain.Pose{}), domain.ErrInvalidOrientation)
+}
+
+func TestClient_Backpressure(t *testing.T) {
+
+	// given
+	hub := NewHub(2)
+	rover := domain.NewRover(domain.NewMap(1, 100))
+	assert.Nil(t, hub.AddRover("curiosity", rover, domain.Pose{Orientation: domain.North}))
+
+	slow := newClient(hub.queueSize)
+	hub.clients[slow] = struct{}{}
+
+	// when
+	result, err := rover.Execute(0, 0, domain.North, strings.Repeat("A", 99))
+
+	//then
+	assert.Nil(t, err)
+	assert.True(t, result.Valid)
+
+	update, ok := slow.next()
+	assert.True(t, ok)
+	assert.Equal(t, 98, update.Step)
+	assert.Equal(t, int64(98), update.Dropped)
+
+	update, ok = slow.next()
+	assert.True(t, ok)
+	assert.Equal(t, 99, update.Step)
+	assert.Equal(t, int64(0), update.Dropped)
+
+	slow.close()
+	slow.enqueue(Update{Type: PoseUpdate})
+	_, ok = slow.next()
+	assert.False(t, ok)
+}
@@ -0,0 +1,320 @@
+package repl
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	domain "github.com/undernet00/MarsRoverGo/pkg/domain"
+)
+
+const help = `Commands:
+  A, L, R, AALAR...  move the rover, any string of commands is accepted
+  place X Y O        place the rover at X,Y facing O (N, E, S or W) and clear the history
+  show               draw the map and the rover, only the cells around it on big maps
+  undo               undo the last string of commands
+  reset              go back to the placement pose and clear the history
+  save FILE          save the map, placement and history to FILE
+  load FILE          load a session saved with save
+  help               show this help
+  quit, exit         leave
+`
+
+// maxShowCells is the largest map show draws whole. Bigger maps are drawn around the Rover.
+const maxShowCells = 64 * 64
+
+// showWindow is the number of cells drawn around the Rover, in every direction, when the map is too big to show.
+const showWindow = 10
+
+// sessionFile is the content of the files written by save and read by load.
+type sessionFile struct {
+	Map       domain.MapSpec `json:"map"`
+	Start     domain.Pose    `json:"start"`
+	Commands  []string       `json:"commands"`
+	Language  string         `json:"language,omitempty"`
+	Lowercase bool           `json:"lowercase,omitempty"`
+}
+
+// Session drives a single Rover on a map one line at a time.
+type Session struct {
+	spec      domain.MapSpec
+	m         domain.PlanetaryMap
+	rover     *domain.Rover
+	start     domain.Pose
+	pose      domain.Pose
+	history   []string
+	poses     []domain.Pose
+	alphabet  domain.Alphabet
+	formatter domain.Formatter
+	out       io.Writer
+}
+
+// NewSession creates a session with a Rover placed at the start pose on the map described by the spec.
+func NewSession(spec domain.MapSpec, start domain.Pose, out io.Writer) (*Session, error) {
+
+	s := Session{alphabet: domain.EnglishAlphabet, out: out}
+	if err := s.setMap(spec); err != nil {
+		return nil, err
+	}
+
+	if err := s.place(start); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// Run reads lines from the input until it ends or a quit command is found, executing each one of them.
+func (s *Session) Run(in io.Reader) error {
+
+	scanner := bufio.NewScanner(in)
+	fmt.Fprint(s.out, "> ")
+	for scanner.Scan() {
+		if !s.Execute(scanner.Text()) {
+			return nil
+		}
+		fmt.Fprint(s.out, "> ")
+	}
+
+	return scanner.Err()
+}
+
+// Execute runs a single line, printing its outcome. It returns false when the session must end.
+func (s *Session) Execute(line string) bool {
+
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return true
+	}
+
+	var err error
+	switch strings.ToLower(fields[0]) {
+	case "quit", "exit":
+		return false
+	case "help":
+		fmt.Fprint(s.out, help)
+	case "show":
+		s.show()
+	case "undo":
+		err = s.undo()
+	case "reset":
+		err = s.place(s.start)
+	case "place":
+		err = s.placeFromArguments(fields[1:])
+	case "save":
+		err = s.save(fields[1:])
+	case "load":
+		err = s.load(fields[1:])
+	default:
+		err = s.travel(strings.Join(fields, ""))
+	}
+
+	if err != nil {
+		fmt.Fprintf(s.out, "error: %v\n", err)
+	}
+
+	return true
+}
+
+// setMap builds the map of the spec and a new Rover on it.
+func (s *Session) setMap(spec domain.MapSpec) error {
+
+	m, err := spec.Build()
+	if err != nil {
+		return err
+	}
+
+	s.spec = spec
+	s.m = m
+	s.rover = domain.NewRover(m)
+	s.rover.UseAlphabet(s.alphabet)
+	s.rover.UseFormatter(s.formatter)
+
+	return nil
+}
+
+// UseAlphabet sets the characters accepted for commands and orientations, and the language of the output.
+func (s *Session) UseAlphabet(alphabet domain.Alphabet) {
+	s.alphabet = alphabet
+	s.rover.UseAlphabet(alphabet)
+}
+
+// UseFormatter sets how the result of every string of commands is printed.
+func (s *Session) UseFormatter(formatter domain.Formatter) {
+	s.formatter = formatter
+	s.rover.UseFormatter(formatter)
+}
+
+// show draws the map with the Rover. Maps of more than maxShowCells cells are drawn only showWindow cells around it.
+func (s *Session) show() {
+
+	bounds, bounded := domain.MapBounds(s.m)
+	if !bounded || bounds.Width*bounds.Height <= maxShowCells {
+		fmt.Fprint(s.out, domain.RenderMap(s.m, &s.pose))
+		return
+	}
+
+	area := domain.Rectangle{X: s.pose.X - showWindow, Y: s.pose.Y - showWindow, Width: 2*showWindow + 1, Height: 2*showWindow + 1}
+	fmt.Fprint(s.out, domain.RenderMapArea(s.m, &s.pose, area))
+}
+
+// place puts the Rover at the pose, which becomes the new start, and clears the history.
+func (s *Session) place(pose domain.Pose) error {
+
+	if !s.m.IsValid(pose.X, pose.Y) {
+		return &domain.InvalidCoordinateError{X: pose.X, Y: pose.Y}
+	}
+
+	if !pose.Orientation.IsValid() {
+		return &domain.InvalidOrientationError{Orientation: pose.Orientation}
+	}
+
+	s.start = pose
+	s.pose = pose
+	s.history = nil
+	s.poses = nil
+	s.printPose()
+
+	return nil
+}
+
+func (s *Session) placeFromArguments(arguments []string) error {
+
+	if len(arguments) != 3 {
+		return errors.New("usage: place X Y O")
+	}
+
+	x, err := strconv.Atoi(arguments[0])
+	if err != nil {
+		return fmt.Errorf("%v is not a valid x coordinate", arguments[0])
+	}
+
+	y, err := strconv.Atoi(arguments[1])
+	if err != nil {
+		return fmt.Errorf("%v is not a valid y coordinate", arguments[1])
+	}
+
+	orientation, err := s.alphabet.ParseOrientation(arguments[2])
+	if err != nil {
+		return err
+	}
+
+	return s.place(domain.Pose{X: x, Y: y, Orientation: orientation})
+}
+
+// travel executes a string of commands from the current pose and records it in the history.
+func (s *Session) travel(commands string) error {
+
+	output, err := s.rover.Travel(s.pose.X, s.pose.Y, s.pose.Orientation, commands)
+	if err != nil {
+		return err
+	}
+
+	s.history = append(s.history, commands)
+	s.poses = append(s.poses, s.pose)
+	s.pose = s.rover.Pose()
+	fmt.Fprintln(s.out, output)
+
+	return nil
+}
+
+func (s *Session) undo() error {
+
+	if len(s.history) == 0 {
+		return errors.New("nothing to undo")
+	}
+
+	last := len(s.history) - 1
+	s.pose = s.poses[last]
+	s.history = s.history[:last]
+	s.poses = s.poses[:last]
+	s.printPose()
+
+	return nil
+}
+
+func (s *Session) save(arguments []string) error {
+
+	if len(arguments) != 1 {
+		return errors.New("usage: save FILE")
+	}
+
+	file := sessionFile{
+		Map:       s.spec,
+		Start:     s.start,
+		Commands:  s.history,
+		Language:  s.alphabet.Language.String(),
+		Lowercase: s.alphabet.CaseInsensitive,
+	}
+
+	content, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(arguments[0], content, 0644); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(s.out, "saved %v\n", arguments[0])
+	return nil
+}
+
+// load replaces the session with the one saved in the file, replaying its history from the start pose with the
+// Alphabet it was saved with. Files without a language are replayed with the Alphabet in use.
+func (s *Session) load(arguments []string) error {
+
+	if len(arguments) != 1 {
+		return errors.New("usage: load FILE")
+	}
+
+	content, err := os.ReadFile(arguments[0])
+	if err != nil {
+		return err
+	}
+
+	file := sessionFile{}
+	if err := json.Unmarshal(content, &file); err != nil {
+		return err
+	}
+
+	alphabet := s.alphabet
+	if file.Language != "" {
+		var ok bool
+		if alphabet, ok = domain.LookupAlphabet(file.Language); !ok {
+			return fmt.Errorf("%v is not a supported language", file.Language)
+		}
+		if file.Lowercase {
+			alphabet = alphabet.WithLowercase()
+		}
+	}
+
+	loaded := Session{alphabet: alphabet, formatter: s.formatter, out: io.Discard}
+	if err := loaded.setMap(file.Map); err != nil {
+		return err
+	}
+	if err := loaded.place(file.Start); err != nil {
+		return err
+	}
+	for _, commands := range file.Commands {
+		if err := loaded.travel(commands); err != nil {
+			return err
+		}
+	}
+
+	loaded.out = s.out
+	*s = loaded
+	fmt.Fprintf(s.out, "loaded %v\n", arguments[0])
+	s.printPose()
+
+	return nil
+}
+
+func (s *Session) printPose() {
+	fmt.Fprintf(s.out, "%v, (%v,%v)\n", s.alphabet.Letter(s.pose.Orientation), s.pose.X, s.pose.Y)
+}
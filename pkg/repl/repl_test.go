@@ -0,0 +1,256 @@
+package repl
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	domain "github.com/undernet00/MarsRoverGo/pkg/domain"
+)
+
+var squareMap = domain.MapSpec{Kind: domain.RectangleMapKind, Width: 3, Height: 3}
+
+func TestNewSession(t *testing.T) {
+	//Given
+	out := &bytes.Buffer{}
+
+	//When
+	session, err := NewSession(squareMap, domain.Pose{X: 1, Y: 1, Orientation: domain.East}, out)
+
+	//Then
+	assert.Nil(t, err)
+	assert.NotNil(t, session)
+	assert.Equal(t, "E, (1,1)\n", out.String())
+
+	//When
+	session, err = NewSession(squareMap, domain.Pose{X: 3, Y: 1, Orientation: domain.East}, out)
+
+	//Then
+	assert.NotNil(t, err)
+	assert.Nil(t, session)
+
+	//When
+	session, err = NewSession(domain.MapSpec{Kind: "unknown"}, domain.Pose{Orientation: domain.East}, out)
+
+	//Then
+	assert.NotNil(t, err)
+	assert.Nil(t, session)
+}
+
+func TestSession_Execute(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		lines   []string
+		asserts func(s *Session, output string)
+	}{
+		{
+			name:  "Commands are executed from the current pose",
+			lines: []string{"A", "R", "AAA"},
+			asserts: func(s *Session, output string) {
+				assert.Equal(t, "True, N, (0,1)\nTrue, E, (0,1)\nFalse, E, (2,1)\n", output)
+				assert.Equal(t, domain.Pose{X: 2, Y: 1, Orientation: domain.East}, s.pose)
+				assert.Equal(t, []string{"A", "R", "AAA"}, s.history)
+			},
+		},
+		{
+			name:  "Invalid commands are reported and not recorded",
+			lines: []string{"AXA"},
+			asserts: func(s *Session, output string) {
+				assert.Equal(t, "error: X at position 2 is not a valid command\n", output)
+				assert.Len(t, s.history, 0)
+			},
+		},
+		{
+			name:  "Show draws the rover",
+			lines: []string{"RA", "show"},
+			asserts: func(s *Session, output string) {
+				assert.Equal(t, "True, E, (1,0)\n...\n...\n.>.\n", output)
+			},
+		},
+		{
+			name:  "Undo goes back one string of commands",
+			lines: []string{"AA", "RA", "undo", "undo", "undo"},
+			asserts: func(s *Session, output string) {
+				assert.Equal(t, "True, N, (0,2)\nTrue, E, (1,2)\nN, (0,2)\nN, (0,0)\nerror: nothing to undo\n", output)
+				assert.Len(t, s.history, 0)
+			},
+		},
+		{
+			name:  "Reset goes back to the placement",
+			lines: []string{"AA", "RA", "reset"},
+			asserts: func(s *Session, output string) {
+				assert.True(t, strings.HasSuffix(output, "N, (0,0)\n"))
+				assert.Len(t, s.history, 0)
+			},
+		},
+		{
+			name:  "Place moves the start pose",
+			lines: []string{"place 2 2 S", "A", "reset", "place 5 5 N", "place 1 x N"},
+			asserts: func(s *Session, output string) {
+				assert.Equal(t, "S, (2,2)\nTrue, S, (2,1)\nS, (2,2)\nerror: (5,5) are not valid x and y coordinates\nerror: x is not a valid y coordinate\n", output)
+				assert.Equal(t, domain.Pose{X: 2, Y: 2, Orientation: domain.South}, s.start)
+			},
+		},
+		{
+			name:  "Blank lines are ignored and help is shown",
+			lines: []string{"", "   ", "help"},
+			asserts: func(s *Session, output string) {
+				assert.Equal(t, help, output)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			out := &bytes.Buffer{}
+			session, err := NewSession(squareMap, domain.Pose{Orientation: domain.North}, out)
+			assert.Nil(t, err)
+			out.Reset()
+
+			// when
+			for _, line := range tt.lines {
+				assert.True(t, session.Execute(line))
+			}
+
+			//then
+			tt.asserts(session, out.String())
+		})
+	}
+}
+
+func TestSession_SaveAndLoad(t *testing.T) {
+	//Given
+	file := filepath.Join(t.TempDir(), "session.json")
+	spec := domain.MapSpec{Kind: domain.SparseMapKind, Width: 4, Height: 4, Obstacles: []domain.Coordinate{{X: 1, Y: 1}}}
+	saved, err := NewSession(spec, domain.Pose{Orientation: domain.North}, &bytes.Buffer{})
+	assert.Nil(t, err)
+	saved.Execute("AA")
+	saved.Execute("RAA")
+
+	//When
+	saved.Execute("save " + file)
+
+	out := &bytes.Buffer{}
+	loaded, err := NewSession(squareMap, domain.Pose{Orientation: domain.South}, out)
+	assert.Nil(t, err)
+	out.Reset()
+	loaded.Execute("load " + file)
+
+	//Then
+	assert.Equal(t, "loaded "+file+"\nE, (2,2)\n", out.String())
+	assert.Equal(t, saved.spec, loaded.spec)
+	assert.Equal(t, saved.history, loaded.history)
+	assert.Equal(t, saved.pose, loaded.pose)
+	assert.False(t, loaded.m.IsValid(1, 1))
+
+	//When
+	out.Reset()
+	loaded.Execute("undo")
+
+	//Then
+	assert.Equal(t, "N, (0,2)\n", out.String())
+
+	//When
+	out.Reset()
+	loaded.Execute("load " + filepath.Join(t.TempDir(), "missing.json"))
+	loaded.Execute("save")
+
+	//Then
+	assert.Contains(t, out.String(), "error: ")
+	assert.Contains(t, out.String(), "error: usage: save FILE\n")
+}
+
+func TestSession_SaveAndLoadAlphabet(t *testing.T) {
+	//Given
+	file := filepath.Join(t.TempDir(), "session.json")
+	saved, err := NewSession(squareMap, domain.Pose{Orientation: domain.North}, &bytes.Buffer{})
+	assert.Nil(t, err)
+	saved.UseAlphabet(domain.SpanishAlphabet.WithLowercase())
+	saved.Execute("aid")
+	saved.Execute("save " + file)
+
+	out := &bytes.Buffer{}
+	loaded, err := NewSession(squareMap, domain.Pose{Orientation: domain.North}, out)
+	assert.Nil(t, err)
+	out.Reset()
+
+	//When
+	loaded.Execute("load " + file)
+	loaded.Execute("d")
+
+	//Then
+	assert.Equal(t, "loaded "+file+"\nN, (0,1)\nVerdadero, E, (0,1)\n", out.String())
+	assert.Equal(t, []string{"aid", "d"}, loaded.history)
+}
+
+func TestSession_ShowBigMap(t *testing.T) {
+	//Given
+	out := &bytes.Buffer{}
+	bigMap := domain.MapSpec{Kind: domain.RectangleMapKind, Width: 1000000, Height: 1000000}
+	session, err := NewSession(bigMap, domain.Pose{X: 5, Y: 500, Orientation: domain.East}, out)
+	assert.Nil(t, err)
+	out.Reset()
+
+	//When
+	session.Execute("show")
+
+	//Then
+	rows := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	assert.Len(t, rows, 2*showWindow+1)
+	assert.Equal(t, strings.Repeat("#", 5)+strings.Repeat(".", 5)+">"+strings.Repeat(".", 10), rows[showWindow])
+}
+
+func TestSession_Run(t *testing.T) {
+	//Given
+	out := &bytes.Buffer{}
+	session, err := NewSession(squareMap, domain.Pose{Orientation: domain.North}, out)
+	assert.Nil(t, err)
+	out.Reset()
+
+	//When
+	err = session.Run(strings.NewReader("A\nquit\nA\n"))
+
+	//Then
+	assert.Nil(t, err)
+	assert.Equal(t, "> True, N, (0,1)\n> ", out.String())
+}
+
+func TestSession_UseAlphabet(t *testing.T) {
+	//Given
+	out := &bytes.Buffer{}
+	session, err := NewSession(squareMap, domain.Pose{Orientation: domain.North}, out)
+	assert.Nil(t, err)
+	out.Reset()
+
+	//When
+	session.UseAlphabet(domain.SpanishAlphabet.WithLowercase())
+	session.Execute("ada")
+	session.Execute("place 2 2 o")
+	session.Execute("a")
+	session.Execute("I")
+	session.Execute("place 2 2 W")
+
+	//Then
+	assert.Equal(t, "Verdadero, E, (1,1)\nO, (2,2)\nVerdadero, O, (1,2)\nVerdadero, S, (1,2)\nerror: W is not a valid orientation\n", out.String())
+}
+
+func TestSession_UseFormatter(t *testing.T) {
+	//Given
+	out := &bytes.Buffer{}
+	session, err := NewSession(squareMap, domain.Pose{Orientation: domain.North}, out)
+	assert.Nil(t, err)
+	out.Reset()
+
+	//When
+	session.UseFormatter(domain.JSONFormatter{})
+	session.Execute("RA")
+	session.UseFormatter(domain.ClassicFormatter{})
+	session.Execute("A")
+
+	//Then
+	assert.Equal(t, "{\"valid\":true,\"pose\":{\"x\":1,\"y\":0,\"orientation\":\"E\"}}\n2 0 E\n", out.String())
+}
@@ -0,0 +1,205 @@
+package rpc
+
+import (
+	"math"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	domain "github.com/undernet00/MarsRoverGo/pkg/domain"
+	"github.com/undernet00/MarsRoverGo/pkg/rpc/roverpb"
+)
+
+// The functions below translate between the domain types and their protobuf messages.
+// Orientations are sent as letters of the Rover's Alphabet, the same ones its start poses are written with.
+
+// toInt32 checks that a value of the domain fits in the protobuf messages. Unbounded and sparse maps allow
+// coordinates that do not.
+func toInt32(v int) (int32, error) {
+
+	if v < math.MinInt32 || v > math.MaxInt32 {
+		return 0, status.Errorf(codes.OutOfRange, "%v does not fit in an int32", v)
+	}
+
+	return int32(v), nil
+}
+
+func coordinateFromProto(c *roverpb.Coordinate) domain.Coordinate {
+	return domain.Coordinate{X: int(c.GetX()), Y: int(c.GetY())}
+}
+
+func coordinateToProto(c domain.Coordinate) (*roverpb.Coordinate, error) {
+
+	x, err := toInt32(c.X)
+	if err != nil {
+		return nil, err
+	}
+
+	y, err := toInt32(c.Y)
+	if err != nil {
+		return nil, err
+	}
+
+	return &roverpb.Coordinate{X: x, Y: y}, nil
+}
+
+func coordinatesFromProto(cs []*roverpb.Coordinate) []domain.Coordinate {
+
+	if len(cs) == 0 {
+		return nil
+	}
+
+	coordinates := make([]domain.Coordinate, 0, len(cs))
+	for _, c := range cs {
+		coordinates = append(coordinates, coordinateFromProto(c))
+	}
+
+	return coordinates
+}
+
+func coordinatesToProto(cs []domain.Coordinate) ([]*roverpb.Coordinate, error) {
+
+	coordinates := make([]*roverpb.Coordinate, 0, len(cs))
+	for _, c := range cs {
+		coordinate, err := coordinateToProto(c)
+		if err != nil {
+			return nil, err
+		}
+		coordinates = append(coordinates, coordinate)
+	}
+
+	return coordinates, nil
+}
+
+func mapSpecFromProto(s *roverpb.MapSpec) domain.MapSpec {
+
+	spec := domain.MapSpec{
+		Kind:      s.GetKind(),
+		Width:     int(s.GetWidth()),
+		Height:    int(s.GetHeight()),
+		Unbounded: s.GetUnbounded(),
+		Vertices:  coordinatesFromProto(s.GetVertices()),
+		Obstacles: coordinatesFromProto(s.GetObstacles()),
+	}
+
+	for _, r := range s.GetRectangles() {
+		spec.Rectangles = append(spec.Rectangles, domain.RectangleSpec{
+			Rectangle: domain.Rectangle{
+				X:      int(r.GetRectangle().GetX()),
+				Y:      int(r.GetRectangle().GetY()),
+				Width:  int(r.GetRectangle().GetWidth()),
+				Height: int(r.GetRectangle().GetHeight()),
+			},
+			Exclude: r.GetExclude(),
+		})
+	}
+
+	return spec
+}
+
+func rectangleToProto(r domain.Rectangle) (*roverpb.Rectangle, error) {
+
+	var values [4]int32
+	for i, v := range []int{r.X, r.Y, r.Width, r.Height} {
+		value, err := toInt32(v)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+
+	return &roverpb.Rectangle{X: values[0], Y: values[1], Width: values[2], Height: values[3]}, nil
+}
+
+func mapSpecToProto(s domain.MapSpec) (*roverpb.MapSpec, error) {
+
+	size, err := rectangleToProto(domain.Rectangle{Width: s.Width, Height: s.Height})
+	if err != nil {
+		return nil, err
+	}
+
+	vertices, err := coordinatesToProto(s.Vertices)
+	if err != nil {
+		return nil, err
+	}
+
+	obstacles, err := coordinatesToProto(s.Obstacles)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := roverpb.MapSpec{
+		Kind:      s.Kind,
+		Width:     size.Width,
+		Height:    size.Height,
+		Unbounded: s.Unbounded,
+		Vertices:  vertices,
+		Obstacles: obstacles,
+	}
+
+	for _, r := range s.Rectangles {
+		rectangle, err := rectangleToProto(r.Rectangle)
+		if err != nil {
+			return nil, err
+		}
+		spec.Rectangles = append(spec.Rectangles, &roverpb.RectangleSpec{Rectangle: rectangle, Exclude: r.Exclude})
+	}
+
+	return &spec, nil
+}
+
+func poseToProto(p domain.Pose, alphabet domain.Alphabet) (*roverpb.Pose, error) {
+
+	coordinate, err := coordinateToProto(domain.Coordinate{X: p.X, Y: p.Y})
+	if err != nil {
+		return nil, err
+	}
+
+	return &roverpb.Pose{X: coordinate.X, Y: coordinate.Y, Orientation: alphabet.Letter(p.Orientation)}, nil
+}
+
+func travelResultToProto(r domain.TravelResult, alphabet domain.Alphabet) (*roverpb.TravelResult, error) {
+
+	pose, err := poseToProto(r.Pose, alphabet)
+	if err != nil {
+		return nil, err
+	}
+
+	result := roverpb.TravelResult{Valid: r.Valid, Pose: pose}
+	for _, d := range r.Detours {
+		blocked, err := coordinateToProto(d.Blocked)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Detours = append(result.Detours, &roverpb.Detour{
+			Step:     int32(d.Step),
+			Blocked:  blocked,
+			Rejoin:   int32(d.Rejoin),
+			Commands: alphabet.LocalizeCommands(d.Commands),
+		})
+	}
+
+	return &result, nil
+}
+
+func stepEventToProto(roverID string, e domain.StepEvent, alphabet domain.Alphabet) (*roverpb.StepEvent, error) {
+
+	pose, err := poseToProto(e.Pose, alphabet)
+	if err != nil {
+		return nil, err
+	}
+
+	event := roverpb.StepEvent{
+		RoverId:  roverID,
+		Step:     int32(e.Step),
+		Pose:     pose,
+		Accepted: e.Accepted,
+		Detour:   e.Detour,
+	}
+	if e.Command != "" {
+		event.Command = alphabet.CommandLetter(e.Command)
+	}
+
+	return &event, nil
+}
@@ -0,0 +1,4 @@
+// Package roverpb holds the protobuf messages and gRPC stubs of the rover service.
+package roverpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative rover.proto
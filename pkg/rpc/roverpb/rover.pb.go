@@ -0,0 +1,1794 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v25.3.0
+// source: rover.proto
+
+package roverpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Coordinate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X int32 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y int32 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *Coordinate) Reset() {
+	*x = Coordinate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rover_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Coordinate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coordinate) ProtoMessage() {}
+
+func (x *Coordinate) ProtoReflect() protoreflect.Message {
+	mi := &file_rover_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coordinate.ProtoReflect.Descriptor instead.
+func (*Coordinate) Descriptor() ([]byte, []int) {
+	return file_rover_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Coordinate) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Coordinate) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type Rectangle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X      int32 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y      int32 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	Width  int32 `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *Rectangle) Reset() {
+	*x = Rectangle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rover_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rectangle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rectangle) ProtoMessage() {}
+
+func (x *Rectangle) ProtoReflect() protoreflect.Message {
+	mi := &file_rover_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rectangle.ProtoReflect.Descriptor instead.
+func (*Rectangle) Descriptor() ([]byte, []int) {
+	return file_rover_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Rectangle) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Rectangle) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Rectangle) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Rectangle) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type RectangleSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rectangle *Rectangle `protobuf:"bytes,1,opt,name=rectangle,proto3" json:"rectangle,omitempty"`
+	Exclude   bool       `protobuf:"varint,2,opt,name=exclude,proto3" json:"exclude,omitempty"`
+}
+
+func (x *RectangleSpec) Reset() {
+	*x = RectangleSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rover_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RectangleSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RectangleSpec) ProtoMessage() {}
+
+func (x *RectangleSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_rover_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RectangleSpec.ProtoReflect.Descriptor instead.
+func (*RectangleSpec) Descriptor() ([]byte, []int) {
+	return file_rover_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RectangleSpec) GetRectangle() *Rectangle {
+	if x != nil {
+		return x.Rectangle
+	}
+	return nil
+}
+
+func (x *RectangleSpec) GetExclude() bool {
+	if x != nil {
+		return x.Exclude
+	}
+	return false
+}
+
+// MapSpec mirrors the map files accepted by the command line.
+type MapSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind       string           `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Width      int32            `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height     int32            `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Unbounded  bool             `protobuf:"varint,4,opt,name=unbounded,proto3" json:"unbounded,omitempty"`
+	Vertices   []*Coordinate    `protobuf:"bytes,5,rep,name=vertices,proto3" json:"vertices,omitempty"`
+	Rectangles []*RectangleSpec `protobuf:"bytes,6,rep,name=rectangles,proto3" json:"rectangles,omitempty"`
+	Obstacles  []*Coordinate    `protobuf:"bytes,7,rep,name=obstacles,proto3" json:"obstacles,omitempty"`
+}
+
+func (x *MapSpec) Reset() {
+	*x = MapSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rover_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapSpec) ProtoMessage() {}
+
+func (x *MapSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_rover_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapSpec.ProtoReflect.Descriptor instead.
+func (*MapSpec) Descriptor() ([]byte, []int) {
+	return file_rover_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MapSpec) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *MapSpec) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *MapSpec) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *MapSpec) GetUnbounded() bool {
+	if x != nil {
+		return x.Unbounded
+	}
+	return false
+}
+
+func (x *MapSpec) GetVertices() []*Coordinate {
+	if x != nil {
+		return x.Vertices
+	}
+	return nil
+}
+
+func (x *MapSpec) GetRectangles() []*RectangleSpec {
+	if x != nil {
+		return x.Rectangles
+	}
+	return nil
+}
+
+func (x *MapSpec) GetObstacles() []*Coordinate {
+	if x != nil {
+		return x.Obstacles
+	}
+	return nil
+}
+
+type Pose struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X int32 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y int32 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	// orientation is a letter of the rover's language, like N/E/S/W in English or N/E/S/O in Spanish.
+	Orientation string `protobuf:"bytes,3,opt,name=orientation,proto3" json:"orientation,omitempty"`
+}
+
+func (x *Pose) Reset() {
+	*x = Pose{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rover_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pose) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pose) ProtoMessage() {}
+
+func (x *Pose) ProtoReflect() protoreflect.Message {
+	mi := &file_rover_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pose.ProtoReflect.Descriptor instead.
+func (*Pose) Descriptor() ([]byte, []int) {
+	return file_rover_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Pose) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Pose) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Pose) GetOrientation() string {
+	if x != nil {
+		return x.Orientation
+	}
+	return ""
+}
+
+type Detour struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Step    int32       `protobuf:"varint,1,opt,name=step,proto3" json:"step,omitempty"`
+	Blocked *Coordinate `protobuf:"bytes,2,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	Rejoin  int32       `protobuf:"varint,3,opt,name=rejoin,proto3" json:"rejoin,omitempty"`
+	// commands are written with the letters of the rover's language, like A/L/R in English or A/I/D in Spanish.
+	Commands string `protobuf:"bytes,4,opt,name=commands,proto3" json:"commands,omitempty"`
+}
+
+func (x *Detour) Reset() {
+	*x = Detour{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rover_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Detour) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Detour) ProtoMessage() {}
+
+func (x *Detour) ProtoReflect() protoreflect.Message {
+	mi := &file_rover_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Detour.ProtoReflect.Descriptor instead.
+func (*Detour) Descriptor() ([]byte, []int) {
+	return file_rover_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Detour) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *Detour) GetBlocked() *Coordinate {
+	if x != nil {
+		return x.Blocked
+	}
+	return nil
+}
+
+func (x *Detour) GetRejoin() int32 {
+	if x != nil {
+		return x.Rejoin
+	}
+	return 0
+}
+
+func (x *Detour) GetCommands() string {
+	if x != nil {
+		return x.Commands
+	}
+	return ""
+}
+
+type TravelResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid   bool      `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Pose    *Pose     `protobuf:"bytes,2,opt,name=pose,proto3" json:"pose,omitempty"`
+	Detours []*Detour `protobuf:"bytes,3,rep,name=detours,proto3" json:"detours,omitempty"`
+}
+
+func (x *TravelResult) Reset() {
+	*x = TravelResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rover_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TravelResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TravelResult) ProtoMessage() {}
+
+func (x *TravelResult) ProtoReflect() protoreflect.Message {
+	mi := &file_rover_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TravelResult.ProtoReflect.Descriptor instead.
+func (*TravelResult) Descriptor() ([]byte, []int) {
+	return file_rover_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TravelResult) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *TravelResult) GetPose() *Pose {
+	if x != nil {
+		return x.Pose
+	}
+	return nil
+}
+
+func (x *TravelResult) GetDetours() []*Detour {
+	if x != nil {
+		return x.Detours
+	}
+	return nil
+}
+
+type StepEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoverId string `protobuf:"bytes,1,opt,name=rover_id,json=roverId,proto3" json:"rover_id,omitempty"`
+	Step    int32  `protobuf:"varint,2,opt,name=step,proto3" json:"step,omitempty"`
+	// command is a letter of the rover's language, empty for the first event of a travel.
+	Command  string `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
+	Pose     *Pose  `protobuf:"bytes,4,opt,name=pose,proto3" json:"pose,omitempty"`
+	Accepted bool   `protobuf:"varint,5,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Detour   bool   `protobuf:"varint,6,opt,name=detour,proto3" json:"detour,omitempty"`
+	// dropped counts the events the stream missed right before this one because the client was too slow.
+	Dropped int64 `protobuf:"varint,7,opt,name=dropped,proto3" json:"dropped,omitempty"`
+}
+
+func (x *StepEvent) Reset() {
+	*x = StepEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rover_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StepEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepEvent) ProtoMessage() {}
+
+func (x *StepEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rover_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepEvent.ProtoReflect.Descriptor instead.
+func (*StepEvent) Descriptor() ([]byte, []int) {
+	return file_rover_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StepEvent) GetRoverId() string {
+	if x != nil {
+		return x.RoverId
+	}
+	return ""
+}
+
+func (x *StepEvent) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *StepEvent) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *StepEvent) GetPose() *Pose {
+	if x != nil {
+		return x.Pose
+	}
+	return nil
+}
+
+func (x *StepEvent) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *StepEvent) GetDetour() bool {
+	if x != nil {
+		return x.Detour
+	}
+	return false
+}
+
+func (x *StepEvent) GetDropped() int64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+type CreateMapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Spec *MapSpec `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+}
+
+func (x *CreateMapRequest) Reset() {
+	*x = CreateMapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rover_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMapRequest) ProtoMessage() {}
+
+func (x *CreateMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rover_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMapRequest.ProtoReflect.Descriptor instead.
+func (*CreateMapRequest) Descriptor() ([]byte, []int) {
+	return file_rover_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateMapRequest) GetSpec() *MapSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+type CreateMapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MapId string `protobuf:"bytes,1,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
+}
+
+func (x *CreateMapResponse) Reset() {
+	*x = CreateMapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rover_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMapResponse) ProtoMessage() {}
+
+func (x *CreateMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rover_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMapResponse.ProtoReflect.Descriptor instead.
+func (*CreateMapResponse) Descriptor() ([]byte, []int) {
+	return file_rover_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateMapResponse) GetMapId() string {
+	if x != nil {
+		return x.MapId
+	}
+	return ""
+}
+
+type GetMapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MapId string `protobuf:"bytes,1,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
+	// render asks for a drawing of the map. It is refused for maps of more than a million cells.
+	Render bool `protobuf:"varint,2,opt,name=render,proto3" json:"render,omitempty"`
+}
+
+func (x *GetMapRequest) Reset() {
+	*x = GetMapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rover_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMapRequest) ProtoMessage() {}
+
+func (x *GetMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rover_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMapRequest.ProtoReflect.Descriptor instead.
+func (*GetMapRequest) Descriptor() ([]byte, []int) {
+	return file_rover_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetMapRequest) GetMapId() string {
+	if x != nil {
+		return x.MapId
+	}
+	return ""
+}
+
+func (x *GetMapRequest) GetRender() bool {
+	if x != nil {
+		return x.Render
+	}
+	return false
+}
+
+type GetMapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Spec *MapSpec `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	// render is only set when the request asked for it.
+	Render string `protobuf:"bytes,2,opt,name=render,proto3" json:"render,omitempty"`
+}
+
+func (x *GetMapResponse) Reset() {
+	*x = GetMapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rover_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMapResponse) ProtoMessage() {}
+
+func (x *GetMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rover_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMapResponse.ProtoReflect.Descriptor instead.
+func (*GetMapResponse) Descriptor() ([]byte, []int) {
+	return file_rover_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetMapResponse) GetSpec() *MapSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *GetMapResponse) GetRender() string {
+	if x != nil {
+		return x.Render
+	}
+	return ""
+}
+
+type DeleteMapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MapId string `protobuf:"bytes,1,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
+}
+
+func (x *DeleteMapRequest) Reset() {
+	*x = DeleteMapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rover_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMapRequest) ProtoMessage() {}
+
+func (x *DeleteMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rover_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMapRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMapRequest) Descriptor() ([]byte, []int) {
+	return file_rover_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteMapRequest) GetMapId() string {
+	if x != nil {
+		return x.MapId
+	}
+	return ""
+}
+
+type DeleteMapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteMapResponse) Reset() {
+	*x = DeleteMapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rover_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMapResponse) ProtoMessage() {}
+
+func (x *DeleteMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rover_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMapResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMapResponse) Descriptor() ([]byte, []int) {
+	return file_rover_proto_rawDescGZIP(), []int{13}
+}
+
+type CreateRoverRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MapId string `protobuf:"bytes,1,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
+	Start *Pose  `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// language of the commands and orientations, en when empty.
+	Language       string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	AutonomyBudget int32  `protobuf:"varint,4,opt,name=autonomy_budget,json=autonomyBudget,proto3" json:"autonomy_budget,omitempty"`
+}
+
+func (x *CreateRoverRequest) Reset() {
+	*x = CreateRoverRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rover_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRoverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoverRequest) ProtoMessage() {}
+
+func (x *CreateRoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rover_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoverRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoverRequest) Descriptor() ([]byte, []int) {
+	return file_rover_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateRoverRequest) GetMapId() string {
+	if x != nil {
+		return x.MapId
+	}
+	return ""
+}
+
+func (x *CreateRoverRequest) GetStart() *Pose {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *CreateRoverRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *CreateRoverRequest) GetAutonomyBudget() int32 {
+	if x != nil {
+		return x.AutonomyBudget
+	}
+	return 0
+}
+
+type CreateRoverResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoverId string `protobuf:"bytes,1,opt,name=rover_id,json=roverId,proto3" json:"rover_id,omitempty"`
+}
+
+func (x *CreateRoverResponse) Reset() {
+	*x = CreateRoverResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rover_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRoverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoverResponse) ProtoMessage() {}
+
+func (x *CreateRoverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rover_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoverResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoverResponse) Descriptor() ([]byte, []int) {
+	return file_rover_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateRoverResponse) GetRoverId() string {
+	if x != nil {
+		return x.RoverId
+	}
+	return ""
+}
+
+type GetRoverRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoverId string `protobuf:"bytes,1,opt,name=rover_id,json=roverId,proto3" json:"rover_id,omitempty"`
+}
+
+func (x *GetRoverRequest) Reset() {
+	*x = GetRoverRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rover_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRoverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoverRequest) ProtoMessage() {}
+
+func (x *GetRoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rover_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoverRequest.ProtoReflect.Descriptor instead.
+func (*GetRoverRequest) Descriptor() ([]byte, []int) {
+	return file_rover_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetRoverRequest) GetRoverId() string {
+	if x != nil {
+		return x.RoverId
+	}
+	return ""
+}
+
+type GetRoverResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoverId string `protobuf:"bytes,1,opt,name=rover_id,json=roverId,proto3" json:"rover_id,omitempty"`
+	MapId   string `protobuf:"bytes,2,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
+	Pose    *Pose  `protobuf:"bytes,3,opt,name=pose,proto3" json:"pose,omitempty"`
+}
+
+func (x *GetRoverResponse) Reset() {
+	*x = GetRoverResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rover_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRoverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoverResponse) ProtoMessage() {}
+
+func (x *GetRoverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rover_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoverResponse.ProtoReflect.Descriptor instead.
+func (*GetRoverResponse) Descriptor() ([]byte, []int) {
+	return file_rover_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetRoverResponse) GetRoverId() string {
+	if x != nil {
+		return x.RoverId
+	}
+	return ""
+}
+
+func (x *GetRoverResponse) GetMapId() string {
+	if x != nil {
+		return x.MapId
+	}
+	return ""
+}
+
+func (x *GetRoverResponse) GetPose() *Pose {
+	if x != nil {
+		return x.Pose
+	}
+	return nil
+}
+
+type SubmitCommandsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoverId  string `protobuf:"bytes,1,opt,name=rover_id,json=roverId,proto3" json:"rover_id,omitempty"`
+	Commands string `protobuf:"bytes,2,opt,name=commands,proto3" json:"commands,omitempty"`
+	// start replaces the current pose of the rover when set.
+	Start *Pose `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+}
+
+func (x *SubmitCommandsRequest) Reset() {
+	*x = SubmitCommandsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rover_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitCommandsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitCommandsRequest) ProtoMessage() {}
+
+func (x *SubmitCommandsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rover_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitCommandsRequest.ProtoReflect.Descriptor instead.
+func (*SubmitCommandsRequest) Descriptor() ([]byte, []int) {
+	return file_rover_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SubmitCommandsRequest) GetRoverId() string {
+	if x != nil {
+		return x.RoverId
+	}
+	return ""
+}
+
+func (x *SubmitCommandsRequest) GetCommands() string {
+	if x != nil {
+		return x.Commands
+	}
+	return ""
+}
+
+func (x *SubmitCommandsRequest) GetStart() *Pose {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+type SubmitCommandsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *TravelResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *SubmitCommandsResponse) Reset() {
+	*x = SubmitCommandsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rover_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitCommandsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitCommandsResponse) ProtoMessage() {}
+
+func (x *SubmitCommandsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rover_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitCommandsResponse.ProtoReflect.Descriptor instead.
+func (*SubmitCommandsResponse) Descriptor() ([]byte, []int) {
+	return file_rover_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SubmitCommandsResponse) GetResult() *TravelResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type StreamTelemetryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoverId string `protobuf:"bytes,1,opt,name=rover_id,json=roverId,proto3" json:"rover_id,omitempty"`
+}
+
+func (x *StreamTelemetryRequest) Reset() {
+	*x = StreamTelemetryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rover_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamTelemetryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamTelemetryRequest) ProtoMessage() {}
+
+func (x *StreamTelemetryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rover_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamTelemetryRequest.ProtoReflect.Descriptor instead.
+func (*StreamTelemetryRequest) Descriptor() ([]byte, []int) {
+	return file_rover_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StreamTelemetryRequest) GetRoverId() string {
+	if x != nil {
+		return x.RoverId
+	}
+	return ""
+}
+
+var File_rover_proto protoreflect.FileDescriptor
+
+var file_rover_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6d,
+	0x61, 0x72, 0x73, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x22, 0x28, 0x0a, 0x0a, 0x43,
+	0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x01, 0x79, 0x22, 0x55, 0x0a, 0x09, 0x52, 0x65, 0x63, 0x74, 0x61, 0x6e, 0x67,
+	0x6c, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78,
+	0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x60, 0x0a, 0x0d,
+	0x52, 0x65, 0x63, 0x74, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x35, 0x0a,
+	0x09, 0x72, 0x65, 0x63, 0x74, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6d, 0x61, 0x72, 0x73, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x63, 0x74, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x09, 0x72, 0x65, 0x63, 0x74, 0x61,
+	0x6e, 0x67, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x22, 0x94,
+	0x02, 0x0a, 0x07, 0x4d, 0x61, 0x70, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x75, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x75, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x76, 0x65,
+	0x72, 0x74, 0x69, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d,
+	0x61, 0x72, 0x73, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x08, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x65, 0x73,
+	0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x74, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x72, 0x73, 0x72, 0x6f, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x74, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x74, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x73, 0x12, 0x36, 0x0a,
+	0x09, 0x6f, 0x62, 0x73, 0x74, 0x61, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6d, 0x61, 0x72, 0x73, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x09, 0x6f, 0x62, 0x73, 0x74,
+	0x61, 0x63, 0x6c, 0x65, 0x73, 0x22, 0x44, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x65, 0x12, 0x0c, 0x0a,
+	0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x69,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x06,
+	0x44, 0x65, 0x74, 0x6f, 0x75, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x32, 0x0a, 0x07, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61,
+	0x72, 0x73, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x72, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x22, 0x7c, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x72, 0x73, 0x72, 0x6f, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x65,
+	0x12, 0x2e, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x72, 0x73, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x74, 0x6f, 0x75, 0x72, 0x52, 0x07, 0x64, 0x65, 0x74, 0x6f, 0x75, 0x72, 0x73,
+	0x22, 0xca, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x65, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x72, 0x73, 0x72, 0x6f, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x65, 0x74, 0x6f, 0x75, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x65, 0x74,
+	0x6f, 0x75, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x22, 0x3d, 0x0a,
+	0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x29, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6d, 0x61, 0x72, 0x73, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x70, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22, 0x2a, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6d, 0x61, 0x70, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d,
+	0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x61, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x70, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d,
+	0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x73, 0x70,
+	0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x72, 0x73, 0x72,
+	0x6f, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x70, 0x65, 0x63, 0x52,
+	0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x29, 0x0a,
+	0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6d, 0x61, 0x70, 0x49, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9a, 0x01,
+	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x70, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x72,
+	0x73, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x75, 0x74, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x5f, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x6f,
+	0x6e, 0x6f, 0x6d, 0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x22, 0x30, 0x0a, 0x13, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6c, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x61, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x70, 0x49, 0x64,
+	0x12, 0x26, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6d, 0x61, 0x72, 0x73, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x73, 0x65, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x65, 0x22, 0x78, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x72, 0x73, 0x72, 0x6f,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x22, 0x4c, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d,
+	0x61, 0x72, 0x73, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x76,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0x33, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f,
+	0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f,
+	0x76, 0x65, 0x72, 0x49, 0x64, 0x32, 0xbf, 0x04, 0x0a, 0x0c, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x70, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x72, 0x73, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x72, 0x73, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x1b,
+	0x2e, 0x6d, 0x61, 0x72, 0x73, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61,
+	0x72, 0x73, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x72, 0x73, 0x72, 0x6f, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x72, 0x73, 0x72, 0x6f, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x6d, 0x61, 0x72, 0x73, 0x72, 0x6f, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x76, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x61, 0x72, 0x73, 0x72,
+	0x6f, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f,
+	0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x08, 0x47,
+	0x65, 0x74, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x6d, 0x61, 0x72, 0x73, 0x72, 0x6f,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x61, 0x72, 0x73, 0x72, 0x6f, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x23, 0x2e, 0x6d, 0x61, 0x72, 0x73, 0x72,
+	0x6f, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x6d, 0x61, 0x72, 0x73, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x24, 0x2e, 0x6d, 0x61, 0x72, 0x73, 0x72, 0x6f, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6d,
+	0x61, 0x72, 0x73, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x65, 0x70,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x30, 0x30,
+	0x2f, 0x4d, 0x61, 0x72, 0x73, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x47, 0x6f, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x72, 0x70, 0x63, 0x2f, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rover_proto_rawDescOnce sync.Once
+	file_rover_proto_rawDescData = file_rover_proto_rawDesc
+)
+
+func file_rover_proto_rawDescGZIP() []byte {
+	file_rover_proto_rawDescOnce.Do(func() {
+		file_rover_proto_rawDescData = protoimpl.X.CompressGZIP(file_rover_proto_rawDescData)
+	})
+	return file_rover_proto_rawDescData
+}
+
+var file_rover_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_rover_proto_goTypes = []any{
+	(*Coordinate)(nil),             // 0: marsrover.v1.Coordinate
+	(*Rectangle)(nil),              // 1: marsrover.v1.Rectangle
+	(*RectangleSpec)(nil),          // 2: marsrover.v1.RectangleSpec
+	(*MapSpec)(nil),                // 3: marsrover.v1.MapSpec
+	(*Pose)(nil),                   // 4: marsrover.v1.Pose
+	(*Detour)(nil),                 // 5: marsrover.v1.Detour
+	(*TravelResult)(nil),           // 6: marsrover.v1.TravelResult
+	(*StepEvent)(nil),              // 7: marsrover.v1.StepEvent
+	(*CreateMapRequest)(nil),       // 8: marsrover.v1.CreateMapRequest
+	(*CreateMapResponse)(nil),      // 9: marsrover.v1.CreateMapResponse
+	(*GetMapRequest)(nil),          // 10: marsrover.v1.GetMapRequest
+	(*GetMapResponse)(nil),         // 11: marsrover.v1.GetMapResponse
+	(*DeleteMapRequest)(nil),       // 12: marsrover.v1.DeleteMapRequest
+	(*DeleteMapResponse)(nil),      // 13: marsrover.v1.DeleteMapResponse
+	(*CreateRoverRequest)(nil),     // 14: marsrover.v1.CreateRoverRequest
+	(*CreateRoverResponse)(nil),    // 15: marsrover.v1.CreateRoverResponse
+	(*GetRoverRequest)(nil),        // 16: marsrover.v1.GetRoverRequest
+	(*GetRoverResponse)(nil),       // 17: marsrover.v1.GetRoverResponse
+	(*SubmitCommandsRequest)(nil),  // 18: marsrover.v1.SubmitCommandsRequest
+	(*SubmitCommandsResponse)(nil), // 19: marsrover.v1.SubmitCommandsResponse
+	(*StreamTelemetryRequest)(nil), // 20: marsrover.v1.StreamTelemetryRequest
+}
+var file_rover_proto_depIdxs = []int32{
+	1,  // 0: marsrover.v1.RectangleSpec.rectangle:type_name -> marsrover.v1.Rectangle
+	0,  // 1: marsrover.v1.MapSpec.vertices:type_name -> marsrover.v1.Coordinate
+	2,  // 2: marsrover.v1.MapSpec.rectangles:type_name -> marsrover.v1.RectangleSpec
+	0,  // 3: marsrover.v1.MapSpec.obstacles:type_name -> marsrover.v1.Coordinate
+	0,  // 4: marsrover.v1.Detour.blocked:type_name -> marsrover.v1.Coordinate
+	4,  // 5: marsrover.v1.TravelResult.pose:type_name -> marsrover.v1.Pose
+	5,  // 6: marsrover.v1.TravelResult.detours:type_name -> marsrover.v1.Detour
+	4,  // 7: marsrover.v1.StepEvent.pose:type_name -> marsrover.v1.Pose
+	3,  // 8: marsrover.v1.CreateMapRequest.spec:type_name -> marsrover.v1.MapSpec
+	3,  // 9: marsrover.v1.GetMapResponse.spec:type_name -> marsrover.v1.MapSpec
+	4,  // 10: marsrover.v1.CreateRoverRequest.start:type_name -> marsrover.v1.Pose
+	4,  // 11: marsrover.v1.GetRoverResponse.pose:type_name -> marsrover.v1.Pose
+	4,  // 12: marsrover.v1.SubmitCommandsRequest.start:type_name -> marsrover.v1.Pose
+	6,  // 13: marsrover.v1.SubmitCommandsResponse.result:type_name -> marsrover.v1.TravelResult
+	8,  // 14: marsrover.v1.RoverService.CreateMap:input_type -> marsrover.v1.CreateMapRequest
+	10, // 15: marsrover.v1.RoverService.GetMap:input_type -> marsrover.v1.GetMapRequest
+	12, // 16: marsrover.v1.RoverService.DeleteMap:input_type -> marsrover.v1.DeleteMapRequest
+	14, // 17: marsrover.v1.RoverService.CreateRover:input_type -> marsrover.v1.CreateRoverRequest
+	16, // 18: marsrover.v1.RoverService.GetRover:input_type -> marsrover.v1.GetRoverRequest
+	18, // 19: marsrover.v1.RoverService.SubmitCommands:input_type -> marsrover.v1.SubmitCommandsRequest
+	20, // 20: marsrover.v1.RoverService.StreamTelemetry:input_type -> marsrover.v1.StreamTelemetryRequest
+	9,  // 21: marsrover.v1.RoverService.CreateMap:output_type -> marsrover.v1.CreateMapResponse
+	11, // 22: marsrover.v1.RoverService.GetMap:output_type -> marsrover.v1.GetMapResponse
+	13, // 23: marsrover.v1.RoverService.DeleteMap:output_type -> marsrover.v1.DeleteMapResponse
+	15, // 24: marsrover.v1.RoverService.CreateRover:output_type -> marsrover.v1.CreateRoverResponse
+	17, // 25: marsrover.v1.RoverService.GetRover:output_type -> marsrover.v1.GetRoverResponse
+	19, // 26: marsrover.v1.RoverService.SubmitCommands:output_type -> marsrover.v1.SubmitCommandsResponse
+	7,  // 27: marsrover.v1.RoverService.StreamTelemetry:output_type -> marsrover.v1.StepEvent
+	21, // [21:28] is the sub-list for method output_type
+	14, // [14:21] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_rover_proto_init() }
+func file_rover_proto_init() {
+	if File_rover_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rover_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Coordinate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rover_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Rectangle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rover_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*RectangleSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rover_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*MapSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rover_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*Pose); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rover_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*Detour); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rover_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*TravelResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rover_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*StepEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rover_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateMapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rover_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateMapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rover_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*GetMapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rover_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*GetMapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rover_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteMapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rover_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteMapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rover_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateRoverRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rover_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateRoverResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rover_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRoverRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rover_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRoverResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rover_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*SubmitCommandsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rover_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*SubmitCommandsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rover_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*StreamTelemetryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rover_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rover_proto_goTypes,
+		DependencyIndexes: file_rover_proto_depIdxs,
+		MessageInfos:      file_rover_proto_msgTypes,
+	}.Build()
+	File_rover_proto = out.File
+	file_rover_proto_rawDesc = nil
+	file_rover_proto_goTypes = nil
+	file_rover_proto_depIdxs = nil
+}
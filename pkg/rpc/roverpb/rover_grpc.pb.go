@@ -0,0 +1,375 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v25.3.0
+// source: rover.proto
+
+package roverpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	RoverService_CreateMap_FullMethodName       = "/marsrover.v1.RoverService/CreateMap"
+	RoverService_GetMap_FullMethodName          = "/marsrover.v1.RoverService/GetMap"
+	RoverService_DeleteMap_FullMethodName       = "/marsrover.v1.RoverService/DeleteMap"
+	RoverService_CreateRover_FullMethodName     = "/marsrover.v1.RoverService/CreateRover"
+	RoverService_GetRover_FullMethodName        = "/marsrover.v1.RoverService/GetRover"
+	RoverService_SubmitCommands_FullMethodName  = "/marsrover.v1.RoverService/SubmitCommands"
+	RoverService_StreamTelemetry_FullMethodName = "/marsrover.v1.RoverService/StreamTelemetry"
+)
+
+// RoverServiceClient is the client API for RoverService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RoverServiceClient interface {
+	// CreateMap builds a map from its spec and returns its id.
+	CreateMap(ctx context.Context, in *CreateMapRequest, opts ...grpc.CallOption) (*CreateMapResponse, error)
+	// GetMap returns the spec of a map, and a drawing of it when asked.
+	GetMap(ctx context.Context, in *GetMapRequest, opts ...grpc.CallOption) (*GetMapResponse, error)
+	// DeleteMap removes a map that has no rovers on it.
+	DeleteMap(ctx context.Context, in *DeleteMapRequest, opts ...grpc.CallOption) (*DeleteMapResponse, error)
+	// CreateRover places a new rover on a map and returns its id.
+	CreateRover(ctx context.Context, in *CreateRoverRequest, opts ...grpc.CallOption) (*CreateRoverResponse, error)
+	// GetRover returns the current pose of a rover.
+	GetRover(ctx context.Context, in *GetRoverRequest, opts ...grpc.CallOption) (*GetRoverResponse, error)
+	// SubmitCommands makes a rover travel and returns the result.
+	SubmitCommands(ctx context.Context, in *SubmitCommandsRequest, opts ...grpc.CallOption) (*SubmitCommandsResponse, error)
+	// StreamTelemetry pushes every step of the following travels of a rover until the client cancels.
+	// The response headers are sent once the subscription is in place.
+	StreamTelemetry(ctx context.Context, in *StreamTelemetryRequest, opts ...grpc.CallOption) (RoverService_StreamTelemetryClient, error)
+}
+
+type roverServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRoverServiceClient(cc grpc.ClientConnInterface) RoverServiceClient {
+	return &roverServiceClient{cc}
+}
+
+func (c *roverServiceClient) CreateMap(ctx context.Context, in *CreateMapRequest, opts ...grpc.CallOption) (*CreateMapResponse, error) {
+	out := new(CreateMapResponse)
+	err := c.cc.Invoke(ctx, RoverService_CreateMap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roverServiceClient) GetMap(ctx context.Context, in *GetMapRequest, opts ...grpc.CallOption) (*GetMapResponse, error) {
+	out := new(GetMapResponse)
+	err := c.cc.Invoke(ctx, RoverService_GetMap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roverServiceClient) DeleteMap(ctx context.Context, in *DeleteMapRequest, opts ...grpc.CallOption) (*DeleteMapResponse, error) {
+	out := new(DeleteMapResponse)
+	err := c.cc.Invoke(ctx, RoverService_DeleteMap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roverServiceClient) CreateRover(ctx context.Context, in *CreateRoverRequest, opts ...grpc.CallOption) (*CreateRoverResponse, error) {
+	out := new(CreateRoverResponse)
+	err := c.cc.Invoke(ctx, RoverService_CreateRover_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roverServiceClient) GetRover(ctx context.Context, in *GetRoverRequest, opts ...grpc.CallOption) (*GetRoverResponse, error) {
+	out := new(GetRoverResponse)
+	err := c.cc.Invoke(ctx, RoverService_GetRover_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roverServiceClient) SubmitCommands(ctx context.Context, in *SubmitCommandsRequest, opts ...grpc.CallOption) (*SubmitCommandsResponse, error) {
+	out := new(SubmitCommandsResponse)
+	err := c.cc.Invoke(ctx, RoverService_SubmitCommands_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roverServiceClient) StreamTelemetry(ctx context.Context, in *StreamTelemetryRequest, opts ...grpc.CallOption) (RoverService_StreamTelemetryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RoverService_ServiceDesc.Streams[0], RoverService_StreamTelemetry_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &roverServiceStreamTelemetryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RoverService_StreamTelemetryClient interface {
+	Recv() (*StepEvent, error)
+	grpc.ClientStream
+}
+
+type roverServiceStreamTelemetryClient struct {
+	grpc.ClientStream
+}
+
+func (x *roverServiceStreamTelemetryClient) Recv() (*StepEvent, error) {
+	m := new(StepEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RoverServiceServer is the server API for RoverService service.
+// All implementations must embed UnimplementedRoverServiceServer
+// for forward compatibility
+type RoverServiceServer interface {
+	// CreateMap builds a map from its spec and returns its id.
+	CreateMap(context.Context, *CreateMapRequest) (*CreateMapResponse, error)
+	// GetMap returns the spec of a map, and a drawing of it when asked.
+	GetMap(context.Context, *GetMapRequest) (*GetMapResponse, error)
+	// DeleteMap removes a map that has no rovers on it.
+	DeleteMap(context.Context, *DeleteMapRequest) (*DeleteMapResponse, error)
+	// CreateRover places a new rover on a map and returns its id.
+	CreateRover(context.Context, *CreateRoverRequest) (*CreateRoverResponse, error)
+	// GetRover returns the current pose of a rover.
+	GetRover(context.Context, *GetRoverRequest) (*GetRoverResponse, error)
+	// SubmitCommands makes a rover travel and returns the result.
+	SubmitCommands(context.Context, *SubmitCommandsRequest) (*SubmitCommandsResponse, error)
+	// StreamTelemetry pushes every step of the following travels of a rover until the client cancels.
+	// The response headers are sent once the subscription is in place.
+	StreamTelemetry(*StreamTelemetryRequest, RoverService_StreamTelemetryServer) error
+	mustEmbedUnimplementedRoverServiceServer()
+}
+
+// UnimplementedRoverServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRoverServiceServer struct {
+}
+
+func (UnimplementedRoverServiceServer) CreateMap(context.Context, *CreateMapRequest) (*CreateMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMap not implemented")
+}
+func (UnimplementedRoverServiceServer) GetMap(context.Context, *GetMapRequest) (*GetMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMap not implemented")
+}
+func (UnimplementedRoverServiceServer) DeleteMap(context.Context, *DeleteMapRequest) (*DeleteMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMap not implemented")
+}
+func (UnimplementedRoverServiceServer) CreateRover(context.Context, *CreateRoverRequest) (*CreateRoverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRover not implemented")
+}
+func (UnimplementedRoverServiceServer) GetRover(context.Context, *GetRoverRequest) (*GetRoverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRover not implemented")
+}
+func (UnimplementedRoverServiceServer) SubmitCommands(context.Context, *SubmitCommandsRequest) (*SubmitCommandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitCommands not implemented")
+}
+func (UnimplementedRoverServiceServer) StreamTelemetry(*StreamTelemetryRequest, RoverService_StreamTelemetryServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTelemetry not implemented")
+}
+func (UnimplementedRoverServiceServer) mustEmbedUnimplementedRoverServiceServer() {}
+
+// UnsafeRoverServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RoverServiceServer will
+// result in compilation errors.
+type UnsafeRoverServiceServer interface {
+	mustEmbedUnimplementedRoverServiceServer()
+}
+
+func RegisterRoverServiceServer(s grpc.ServiceRegistrar, srv RoverServiceServer) {
+	s.RegisterService(&RoverService_ServiceDesc, srv)
+}
+
+func _RoverService_CreateMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoverServiceServer).CreateMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoverService_CreateMap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoverServiceServer).CreateMap(ctx, req.(*CreateMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoverService_GetMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoverServiceServer).GetMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoverService_GetMap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoverServiceServer).GetMap(ctx, req.(*GetMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoverService_DeleteMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoverServiceServer).DeleteMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoverService_DeleteMap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoverServiceServer).DeleteMap(ctx, req.(*DeleteMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoverService_CreateRover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoverServiceServer).CreateRover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoverService_CreateRover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoverServiceServer).CreateRover(ctx, req.(*CreateRoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoverService_GetRover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoverServiceServer).GetRover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoverService_GetRover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoverServiceServer).GetRover(ctx, req.(*GetRoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoverService_SubmitCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitCommandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoverServiceServer).SubmitCommands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoverService_SubmitCommands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoverServiceServer).SubmitCommands(ctx, req.(*SubmitCommandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoverService_StreamTelemetry_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamTelemetryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RoverServiceServer).StreamTelemetry(m, &roverServiceStreamTelemetryServer{stream})
+}
+
+type RoverService_StreamTelemetryServer interface {
+	Send(*StepEvent) error
+	grpc.ServerStream
+}
+
+type roverServiceStreamTelemetryServer struct {
+	grpc.ServerStream
+}
+
+func (x *roverServiceStreamTelemetryServer) Send(m *StepEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// RoverService_ServiceDesc is the grpc.ServiceDesc for RoverService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RoverService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "marsrover.v1.RoverService",
+	HandlerType: (*RoverServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateMap",
+			Handler:    _RoverService_CreateMap_Handler,
+		},
+		{
+			MethodName: "GetMap",
+			Handler:    _RoverService_GetMap_Handler,
+		},
+		{
+			MethodName: "DeleteMap",
+			Handler:    _RoverService_DeleteMap_Handler,
+		},
+		{
+			MethodName: "CreateRover",
+			Handler:    _RoverService_CreateRover_Handler,
+		},
+		{
+			MethodName: "GetRover",
+			Handler:    _RoverService_GetRover_Handler,
+		},
+		{
+			MethodName: "SubmitCommands",
+			Handler:    _RoverService_SubmitCommands_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamTelemetry",
+			Handler:       _RoverService_StreamTelemetry_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rover.proto",
+}
@@ -0,0 +1,354 @@
+// Package rpc serves maps and rovers over gRPC.
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	domain "github.com/undernet00/MarsRoverGo/pkg/domain"
+	"github.com/undernet00/MarsRoverGo/pkg/rpc/roverpb"
+)
+
+// maxRenderCells is the largest map GetMap draws. Drawings take a byte per cell.
+const maxRenderCells = 1 << 20
+
+// telemetryBuffer is how many step events a telemetry stream can fall behind before its oldest ones are dropped.
+const telemetryBuffer = 64
+
+// Server implements roverpb.RoverServiceServer keeping maps and rovers in memory.
+type Server struct {
+	roverpb.UnimplementedRoverServiceServer
+
+	mu        sync.Mutex
+	maps      map[string]*mapEntry
+	rovers    map[string]*roverEntry
+	lastMap   int
+	lastRover int
+}
+
+type mapEntry struct {
+	spec   domain.MapSpec
+	m      domain.PlanetaryMap
+	rovers int
+}
+
+// roverEntry is a Rover with the pose it was left at. Travels on the same Rover are serialized by mu.
+type roverEntry struct {
+	id       string
+	mapID    string
+	mu       sync.Mutex
+	rover    *domain.Rover
+	alphabet domain.Alphabet
+	pose     domain.Pose
+
+	subscribersMu  sync.Mutex
+	subscribers    map[int]*subscriber
+	lastSubscriber int
+}
+
+// subscriber is the queue of a telemetry stream. Travels never wait for it: when it is full the oldest event is
+// dropped and counted, so a client that stops reading can not block the Rover.
+type subscriber struct {
+	events  chan domain.StepEvent
+	dropped atomic.Int64
+}
+
+// NewServer creates a Server without maps or rovers.
+func NewServer() *Server {
+	return &Server{
+		maps:   make(map[string]*mapEntry),
+		rovers: make(map[string]*roverEntry),
+	}
+}
+
+// Register adds the Server to a gRPC server.
+func (s *Server) Register(registrar grpc.ServiceRegistrar) {
+	roverpb.RegisterRoverServiceServer(registrar, s)
+}
+
+// CreateMap will build the map described by the spec and store it.
+func (s *Server) CreateMap(_ context.Context, request *roverpb.CreateMapRequest) (*roverpb.CreateMapResponse, error) {
+
+	spec := mapSpecFromProto(request.GetSpec())
+	m, err := spec.Build()
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastMap++
+	id := fmt.Sprintf("map-%v", s.lastMap)
+	s.maps[id] = &mapEntry{spec: spec, m: m}
+
+	return &roverpb.CreateMapResponse{MapId: id}, nil
+}
+
+// GetMap returns the spec of a map, and a drawing of it when the request asks for one. Maps of more than
+// maxRenderCells cells are not drawn.
+func (s *Server) GetMap(_ context.Context, request *roverpb.GetMapRequest) (*roverpb.GetMapResponse, error) {
+
+	s.mu.Lock()
+	entry, ok := s.maps[request.GetMapId()]
+	s.mu.Unlock()
+
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "map %v does not exist", request.GetMapId())
+	}
+
+	spec, err := mapSpecToProto(entry.spec)
+	if err != nil {
+		return nil, err
+	}
+
+	response := roverpb.GetMapResponse{Spec: spec}
+	if request.GetRender() {
+		if bounds, bounded := domain.MapBounds(entry.m); bounded && bounds.Width*bounds.Height > maxRenderCells {
+			return nil, status.Errorf(codes.FailedPrecondition, "map %v has %v cells, more than the %v that can be rendered",
+				request.GetMapId(), bounds.Width*bounds.Height, maxRenderCells)
+		}
+		response.Render = domain.RenderMap(entry.m, nil)
+	}
+
+	return &response, nil
+}
+
+// DeleteMap removes a map, which must not have rovers.
+func (s *Server) DeleteMap(_ context.Context, request *roverpb.DeleteMapRequest) (*roverpb.DeleteMapResponse, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.maps[request.GetMapId()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "map %v does not exist", request.GetMapId())
+	}
+
+	if entry.rovers > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "map %v has %v rovers", request.GetMapId(), entry.rovers)
+	}
+
+	delete(s.maps, request.GetMapId())
+	return &roverpb.DeleteMapResponse{}, nil
+}
+
+// CreateRover will place a new Rover at the start pose of a map.
+func (s *Server) CreateRover(_ context.Context, request *roverpb.CreateRoverRequest) (*roverpb.CreateRoverResponse, error) {
+
+	language := request.GetLanguage()
+	if language == "" {
+		language = "en"
+	}
+
+	alphabet, ok := domain.LookupAlphabet(language)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "%v is not a supported language", language)
+	}
+
+	orientation, err := alphabet.ParseOrientation(request.GetStart().GetOrientation())
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+	start := domain.Pose{X: int(request.GetStart().GetX()), Y: int(request.GetStart().GetY()), Orientation: orientation}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m, ok := s.maps[request.GetMapId()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "map %v does not exist", request.GetMapId())
+	}
+
+	if !m.m.IsValid(start.X, start.Y) {
+		return nil, statusFromError(&domain.InvalidCoordinateError{X: start.X, Y: start.Y})
+	}
+
+	s.lastRover++
+	entry := roverEntry{
+		id:          fmt.Sprintf("rover-%v", s.lastRover),
+		mapID:       request.GetMapId(),
+		rover:       domain.NewRover(m.m),
+		alphabet:    alphabet,
+		pose:        start,
+		subscribers: make(map[int]*subscriber),
+	}
+	entry.rover.UseAlphabet(alphabet)
+	entry.rover.EnableAutonomy(int(request.GetAutonomyBudget()))
+	entry.rover.OnStep(entry.publish)
+
+	m.rovers++
+	s.rovers[entry.id] = &entry
+
+	return &roverpb.CreateRoverResponse{RoverId: entry.id}, nil
+}
+
+// GetRover returns the pose a Rover was left at by its last travel.
+func (s *Server) GetRover(_ context.Context, request *roverpb.GetRoverRequest) (*roverpb.GetRoverResponse, error) {
+
+	entry, err := s.rover(request.GetRoverId())
+	if err != nil {
+		return nil, err
+	}
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	pose, err := poseToProto(entry.pose, entry.alphabet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &roverpb.GetRoverResponse{RoverId: entry.id, MapId: entry.mapID, Pose: pose}, nil
+}
+
+// SubmitCommands will make a Rover travel from its current pose, or from the start of the request when it is set.
+func (s *Server) SubmitCommands(_ context.Context, request *roverpb.SubmitCommandsRequest) (*roverpb.SubmitCommandsResponse, error) {
+
+	entry, err := s.rover(request.GetRoverId())
+	if err != nil {
+		return nil, err
+	}
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	start := entry.pose
+	if requestStart := request.GetStart(); requestStart != nil {
+		orientation, err := entry.alphabet.ParseOrientation(requestStart.GetOrientation())
+		if err != nil {
+			return nil, statusFromError(err)
+		}
+		start = domain.Pose{X: int(requestStart.GetX()), Y: int(requestStart.GetY()), Orientation: orientation}
+	}
+
+	result, err := entry.rover.Execute(start.X, start.Y, start.Orientation, request.GetCommands())
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+	entry.pose = result.Pose
+
+	response, err := travelResultToProto(result, entry.alphabet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &roverpb.SubmitCommandsResponse{Result: response}, nil
+}
+
+// StreamTelemetry will send every step of the Rover's travels until the client goes away.
+func (s *Server) StreamTelemetry(request *roverpb.StreamTelemetryRequest, stream roverpb.RoverService_StreamTelemetryServer) error {
+
+	entry, err := s.rover(request.GetRoverId())
+	if err != nil {
+		return err
+	}
+
+	sub := subscriber{events: make(chan domain.StepEvent, telemetryBuffer)}
+	remove := entry.subscribe(&sub)
+	defer remove()
+
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event := <-sub.events:
+			message, err := stepEventToProto(entry.id, event, entry.alphabet)
+			if err != nil {
+				return err
+			}
+			message.Dropped = sub.dropped.Swap(0)
+
+			if err := stream.Send(message); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *Server) rover(id string) (*roverEntry, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.rovers[id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "rover %v does not exist", id)
+	}
+
+	return entry, nil
+}
+
+// subscribe adds a telemetry stream to the Rover. The returned function removes it.
+func (e *roverEntry) subscribe(sub *subscriber) func() {
+
+	e.subscribersMu.Lock()
+	defer e.subscribersMu.Unlock()
+
+	e.lastSubscriber++
+	id := e.lastSubscriber
+	e.subscribers[id] = sub
+
+	return func() {
+		e.subscribersMu.Lock()
+		defer e.subscribersMu.Unlock()
+		delete(e.subscribers, id)
+	}
+}
+
+// publish queues a step event for every telemetry stream of the Rover without waiting for any of them.
+func (e *roverEntry) publish(event domain.StepEvent) {
+
+	e.subscribersMu.Lock()
+	defer e.subscribersMu.Unlock()
+
+	for _, sub := range e.subscribers {
+		sub.enqueue(event)
+	}
+}
+
+// enqueue adds an event to the queue, dropping the oldest one when it is full. It never blocks.
+// It is called with the Rover's subscribersMu held, so there is a single writer.
+func (sub *subscriber) enqueue(event domain.StepEvent) {
+
+	for {
+		select {
+		case sub.events <- event:
+			return
+		default:
+		}
+
+		select {
+		case <-sub.events:
+			sub.dropped.Add(1)
+		default:
+		}
+	}
+}
+
+// statusFromError translates domain errors to gRPC status errors.
+func statusFromError(err error) error {
+
+	switch {
+	case errors.Is(err, domain.ErrInvalidMapSpec),
+		errors.Is(err, domain.ErrEmptyCommands),
+		errors.Is(err, domain.ErrInvalidCommand),
+		errors.Is(err, domain.ErrInvalidCoordinate),
+		errors.Is(err, domain.ErrInvalidOrientation):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,384 @@
+package rpc
+
+import (
+	"context"
+	"math"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/undernet00/MarsRoverGo/pkg/rpc/roverpb"
+)
+
+// newClient serves a new Server in memory and returns a client connected to it.
+func newClient(t *testing.T) roverpb.RoverServiceClient {
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	NewServer().Register(server)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	connection, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(t, err)
+	t.Cleanup(func() { connection.Close() })
+
+	return roverpb.NewRoverServiceClient(connection)
+}
+
+func createRover(t *testing.T, client roverpb.RoverServiceClient, request *roverpb.CreateRoverRequest) string {
+
+	ctx := context.Background()
+	createdMap, err := client.CreateMap(ctx, &roverpb.CreateMapRequest{Spec: &roverpb.MapSpec{Kind: "rectangle", Width: 4, Height: 4}})
+	assert.Nil(t, err)
+
+	request.MapId = createdMap.GetMapId()
+	createdRover, err := client.CreateRover(ctx, request)
+	assert.Nil(t, err)
+
+	return createdRover.GetRoverId()
+}
+
+func TestServer_Maps(t *testing.T) {
+
+	// given
+	client := newClient(t)
+	ctx := context.Background()
+	spec := &roverpb.MapSpec{Kind: "sparse", Width: 3, Height: 2, Obstacles: []*roverpb.Coordinate{{X: 1, Y: 1}}}
+
+	// when
+	created, err := client.CreateMap(ctx, &roverpb.CreateMapRequest{Spec: spec})
+
+	//then
+	assert.Nil(t, err)
+	assert.Equal(t, "map-1", created.GetMapId())
+
+	got, err := client.GetMap(ctx, &roverpb.GetMapRequest{MapId: created.GetMapId(), Render: true})
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(spec, got.GetSpec()))
+	assert.Equal(t, ".#.\n...\n", got.GetRender())
+
+	got, err = client.GetMap(ctx, &roverpb.GetMapRequest{MapId: created.GetMapId()})
+	assert.Nil(t, err)
+	assert.Empty(t, got.GetRender())
+
+	_, err = client.DeleteMap(ctx, &roverpb.DeleteMapRequest{MapId: created.GetMapId()})
+	assert.Nil(t, err)
+
+	_, err = client.GetMap(ctx, &roverpb.GetMapRequest{MapId: created.GetMapId()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestServer_Errors(t *testing.T) {
+
+	client := newClient(t)
+	ctx := context.Background()
+	roverID := createRover(t, client, &roverpb.CreateRoverRequest{Start: &roverpb.Pose{Orientation: "N"}})
+
+	unboundedMap, err := client.CreateMap(ctx, &roverpb.CreateMapRequest{Spec: &roverpb.MapSpec{Kind: "sparse", Unbounded: true}})
+	assert.Nil(t, err)
+	farRover, err := client.CreateRover(ctx, &roverpb.CreateRoverRequest{MapId: unboundedMap.GetMapId(), Start: &roverpb.Pose{X: math.MaxInt32, Orientation: "E"}})
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		name    string
+		call    func() error
+		code    codes.Code
+		message string
+	}{
+		{
+			name: "Invalid map spec",
+			call: func() error {
+				_, err := client.CreateMap(ctx, &roverpb.CreateMapRequest{Spec: &roverpb.MapSpec{Kind: "rectangle"}})
+				return err
+			},
+			code:    codes.InvalidArgument,
+			message: "invalid map spec: 0x0 is not a valid map size",
+		},
+		{
+			name: "Map with rovers can not be deleted",
+			call: func() error {
+				_, err := client.DeleteMap(ctx, &roverpb.DeleteMapRequest{MapId: "map-1"})
+				return err
+			},
+			code:    codes.FailedPrecondition,
+			message: "map map-1 has 1 rovers",
+		},
+		{
+			name: "Huge maps are not rendered",
+			call: func() error {
+				huge, err := client.CreateMap(ctx, &roverpb.CreateMapRequest{Spec: &roverpb.MapSpec{Kind: "rectangle", Width: 1000000, Height: 1000000}})
+				if err != nil {
+					return err
+				}
+				_, err = client.GetMap(ctx, &roverpb.GetMapRequest{MapId: huge.GetMapId(), Render: true})
+				return err
+			},
+			code:    codes.FailedPrecondition,
+			message: "map map-3 has 1000000000000 cells, more than the 1048576 that can be rendered",
+		},
+		{
+			name: "Rover on a missing map",
+			call: func() error {
+				_, err := client.CreateRover(ctx, &roverpb.CreateRoverRequest{MapId: "map-9", Start: &roverpb.Pose{Orientation: "N"}})
+				return err
+			},
+			code:    codes.NotFound,
+			message: "map map-9 does not exist",
+		},
+		{
+			name: "Rover outside the map",
+			call: func() error {
+				_, err := client.CreateRover(ctx, &roverpb.CreateRoverRequest{MapId: "map-1", Start: &roverpb.Pose{X: 4, Orientation: "N"}})
+				return err
+			},
+			code:    codes.InvalidArgument,
+			message: "(4,0) are not valid x and y coordinates",
+		},
+		{
+			name: "Unsupported language",
+			call: func() error {
+				_, err := client.CreateRover(ctx, &roverpb.CreateRoverRequest{MapId: "map-1", Start: &roverpb.Pose{Orientation: "N"}, Language: "fr"})
+				return err
+			},
+			code:    codes.InvalidArgument,
+			message: "fr is not a supported language",
+		},
+		{
+			name: "Invalid commands",
+			call: func() error {
+				_, err := client.SubmitCommands(ctx, &roverpb.SubmitCommandsRequest{RoverId: roverID, Commands: "AX"})
+				return err
+			},
+			code:    codes.InvalidArgument,
+			message: "X at position 2 is not a valid command",
+		},
+		{
+			name: "Missing rover",
+			call: func() error {
+				_, err := client.GetRover(ctx, &roverpb.GetRoverRequest{RoverId: "rover-9"})
+				return err
+			},
+			code:    codes.NotFound,
+			message: "rover rover-9 does not exist",
+		},
+		{
+			name: "Pose out of the int32 range",
+			call: func() error {
+				_, err := client.SubmitCommands(ctx, &roverpb.SubmitCommandsRequest{RoverId: farRover.GetRoverId(), Commands: "A"})
+				return err
+			},
+			code:    codes.OutOfRange,
+			message: "2147483648 does not fit in an int32",
+		},
+		{
+			name: "Start orientation in another language",
+			call: func() error {
+				_, err := client.SubmitCommands(ctx, &roverpb.SubmitCommandsRequest{RoverId: roverID, Commands: "A", Start: &roverpb.Pose{Orientation: "O"}})
+				return err
+			},
+			code:    codes.InvalidArgument,
+			message: "O is not a valid orientation",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			// when
+			err := tc.call()
+
+			//then
+			assert.Equal(t, tc.code, status.Code(err))
+			assert.Equal(t, tc.message, status.Convert(err).Message())
+		})
+	}
+}
+
+func TestServer_SubmitCommands(t *testing.T) {
+
+	client := newClient(t)
+	ctx := context.Background()
+
+	testCases := []struct {
+		name     string
+		rover    *roverpb.CreateRoverRequest
+		requests []*roverpb.SubmitCommandsRequest
+		asserts  func(result *roverpb.TravelResult, pose *roverpb.Pose)
+	}{
+		{
+			name:     "Travels continue from the last pose",
+			rover:    &roverpb.CreateRoverRequest{Start: &roverpb.Pose{Orientation: "N"}},
+			requests: []*roverpb.SubmitCommandsRequest{{Commands: "AR"}, {Commands: "AA"}},
+			asserts: func(result *roverpb.TravelResult, pose *roverpb.Pose) {
+				assert.True(t, proto.Equal(&roverpb.TravelResult{Valid: true, Pose: &roverpb.Pose{X: 2, Y: 1, Orientation: "E"}}, result))
+				assert.True(t, proto.Equal(result.GetPose(), pose))
+			},
+		},
+		{
+			name:     "Start replaces the pose",
+			rover:    &roverpb.CreateRoverRequest{Start: &roverpb.Pose{Orientation: "N"}},
+			requests: []*roverpb.SubmitCommandsRequest{{Commands: "A", Start: &roverpb.Pose{X: 0, Y: 3, Orientation: "N"}}},
+			asserts: func(result *roverpb.TravelResult, pose *roverpb.Pose) {
+				assert.True(t, proto.Equal(&roverpb.TravelResult{Valid: false, Pose: &roverpb.Pose{X: 0, Y: 3, Orientation: "N"}}, result))
+			},
+		},
+		{
+			name:     "Spanish rover",
+			rover:    &roverpb.CreateRoverRequest{Start: &roverpb.Pose{X: 3, Orientation: "O"}, Language: "es"},
+			requests: []*roverpb.SubmitCommandsRequest{{Commands: "A"}, {Commands: "AD"}},
+			asserts: func(result *roverpb.TravelResult, pose *roverpb.Pose) {
+				assert.True(t, proto.Equal(&roverpb.TravelResult{Valid: true, Pose: &roverpb.Pose{X: 1, Y: 0, Orientation: "N"}}, result))
+			},
+		},
+		{
+			name:     "Spanish rover facing West",
+			rover:    &roverpb.CreateRoverRequest{Start: &roverpb.Pose{X: 2, Y: 1, Orientation: "N"}, Language: "es"},
+			requests: []*roverpb.SubmitCommandsRequest{{Commands: "I"}, {Commands: "A"}, {Commands: "A", Start: &roverpb.Pose{X: 3, Y: 3, Orientation: "O"}}},
+			asserts: func(result *roverpb.TravelResult, pose *roverpb.Pose) {
+				assert.True(t, proto.Equal(&roverpb.TravelResult{Valid: true, Pose: &roverpb.Pose{X: 2, Y: 3, Orientation: "O"}}, result))
+				assert.True(t, proto.Equal(result.GetPose(), pose))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			// given
+			roverID := createRover(t, client, tc.rover)
+
+			// when
+			var result *roverpb.TravelResult
+			for _, request := range tc.requests {
+				request.RoverId = roverID
+				response, err := client.SubmitCommands(ctx, request)
+				assert.Nil(t, err)
+				result = response.GetResult()
+			}
+
+			//then
+			got, err := client.GetRover(ctx, &roverpb.GetRoverRequest{RoverId: roverID})
+			assert.Nil(t, err)
+			tc.asserts(result, got.GetPose())
+		})
+	}
+}
+
+func TestServer_StreamTelemetry(t *testing.T) {
+
+	// given
+	client := newClient(t)
+	roverID := createRover(t, client, &roverpb.CreateRoverRequest{Start: &roverpb.Pose{X: 0, Y: 2, Orientation: "N"}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := client.StreamTelemetry(ctx, &roverpb.StreamTelemetryRequest{RoverId: roverID})
+	assert.Nil(t, err)
+	_, err = stream.Header()
+	assert.Nil(t, err)
+
+	// when
+	_, err = client.SubmitCommands(context.Background(), &roverpb.SubmitCommandsRequest{RoverId: roverID, Commands: "RAL"})
+	assert.Nil(t, err)
+	_, err = client.SubmitCommands(context.Background(), &roverpb.SubmitCommandsRequest{RoverId: roverID, Commands: "AA"})
+	assert.Nil(t, err)
+
+	//then
+	expected := []*roverpb.StepEvent{
+		{RoverId: roverID, Step: 0, Pose: &roverpb.Pose{X: 0, Y: 2, Orientation: "N"}, Accepted: true},
+		{RoverId: roverID, Step: 1, Command: "R", Pose: &roverpb.Pose{X: 0, Y: 2, Orientation: "E"}, Accepted: true},
+		{RoverId: roverID, Step: 2, Command: "A", Pose: &roverpb.Pose{X: 1, Y: 2, Orientation: "E"}, Accepted: true},
+		{RoverId: roverID, Step: 3, Command: "L", Pose: &roverpb.Pose{X: 1, Y: 2, Orientation: "N"}, Accepted: true},
+		{RoverId: roverID, Step: 0, Pose: &roverpb.Pose{X: 1, Y: 2, Orientation: "N"}, Accepted: true},
+		{RoverId: roverID, Step: 1, Command: "A", Pose: &roverpb.Pose{X: 1, Y: 3, Orientation: "N"}, Accepted: true},
+		{RoverId: roverID, Step: 2, Command: "A", Pose: &roverpb.Pose{X: 1, Y: 3, Orientation: "N"}, Accepted: false},
+	}
+
+	for _, want := range expected {
+		event, err := stream.Recv()
+		assert.Nil(t, err)
+		assert.True(t, proto.Equal(want, event), "expected %v but got %v", want, event)
+	}
+
+	cancel()
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Canceled, status.Code(err))
+}
+
+func TestServer_StreamTelemetry_SlowClient(t *testing.T) {
+
+	// given
+	client := newClient(t)
+	roverID := createRover(t, client, &roverpb.CreateRoverRequest{Start: &roverpb.Pose{Orientation: "N"}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stream, err := client.StreamTelemetry(ctx, &roverpb.StreamTelemetryRequest{RoverId: roverID})
+	assert.Nil(t, err)
+	_, err = stream.Header()
+	assert.Nil(t, err)
+
+	// when
+	for i := 0; i < 10; i++ {
+		_, err = client.SubmitCommands(ctx, &roverpb.SubmitCommandsRequest{RoverId: roverID, Commands: strings.Repeat("R", 10000)})
+		assert.Nil(t, err)
+	}
+
+	//then
+	dropped := int64(0)
+	for dropped == 0 {
+		event, err := stream.Recv()
+		if !assert.Nil(t, err) {
+			return
+		}
+		dropped = event.GetDropped()
+	}
+	assert.Greater(t, dropped, int64(0))
+}
+
+func TestServer_SpanishCommands(t *testing.T) {
+
+	// given
+	client := newClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	createdMap, err := client.CreateMap(ctx, &roverpb.CreateMapRequest{Spec: &roverpb.MapSpec{Kind: "sparse", Width: 3, Height: 3, Obstacles: []*roverpb.Coordinate{{X: 0, Y: 1}}}})
+	assert.Nil(t, err)
+	createdRover, err := client.CreateRover(ctx, &roverpb.CreateRoverRequest{MapId: createdMap.GetMapId(), Start: &roverpb.Pose{Orientation: "N"}, Language: "es", AutonomyBudget: 4})
+	assert.Nil(t, err)
+
+	stream, err := client.StreamTelemetry(ctx, &roverpb.StreamTelemetryRequest{RoverId: createdRover.GetRoverId()})
+	assert.Nil(t, err)
+	_, err = stream.Header()
+	assert.Nil(t, err)
+
+	// when
+	response, err := client.SubmitCommands(ctx, &roverpb.SubmitCommandsRequest{RoverId: createdRover.GetRoverId(), Commands: "AAD"})
+
+	//then
+	assert.Nil(t, err)
+	assert.Equal(t, "DAIAAIAD", response.GetResult().GetDetours()[0].GetCommands())
+
+	commands := []string{}
+	for len(commands) < 10 {
+		event, err := stream.Recv()
+		if !assert.Nil(t, err) {
+			return
+		}
+		commands = append(commands, event.GetCommand())
+	}
+	assert.Equal(t, []string{"", "D", "A", "I", "A", "A", "I", "A", "D", "D"}, commands)
+}